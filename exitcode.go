@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+// Exit statuses, so scripts can tell why the tool stopped.
+const (
+	// exitFailure is the status of unexpected errors.
+	exitFailure = 1
+
+	// exitUsage is the status of invalid command line arguments, as used by the argument parser.
+	exitUsage = 2
+
+	// exitConfig is the status of invalid or unreadable configuration.
+	exitConfig = 3
+
+	// exitGit is the status of failed git commands, e.g. when the base revision does not exist.
+	exitGit = 4
+
+	// exitGoToolchain is the status of failed go commands, e.g. when the module does not load.
+	exitGoToolchain = 5
+
+	// exitIO is the status of files that could not be read or written, e.g. a template, timings,
+	// an output file or the GitHub Actions event payload.
+	exitIO = 6
+
+	// exitAffected is the status when some package is affected and --exit-code is given.
+	exitAffected = 10
+)
+
+// configError marks errors caused by the configuration.
+type configError struct {
+	error
+}
+
+func (e configError) Unwrap() error {
+	return e.error
+}
+
+// fatal logs the message and exits with the given status.
+func fatal(status int, format string, args ...any) {
+	log.Printf(format, args...)
+	os.Exit(status)
+}
+
+// exitStatus returns the exit status matching the cause of the given error.
+func exitStatus(err error) int {
+	var cmdErr *rippler.CommandError
+	if errors.As(err, &cmdErr) {
+		switch cmdErr.Tool {
+		case rippler.ToolGit:
+			return exitGit
+		case rippler.ToolGo:
+			return exitGoToolchain
+		}
+	}
+
+	var patternErr *rippler.PatternError
+	if errors.As(err, &configError{}) || errors.As(err, &patternErr) {
+		return exitConfig
+	}
+
+	if errors.As(err, new(*fs.PathError)) {
+		return exitIO
+	}
+
+	return exitFailure
+}
+
+// usageStatus returns the exit status of errors setting up the outputs: invalid arguments,
+// unless a file they name could not be read.
+func usageStatus(err error) int {
+	if errors.As(err, new(*fs.PathError)) {
+		return exitIO
+	}
+
+	return exitUsage
+}
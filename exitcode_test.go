@@ -0,0 +1,47 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os/exec"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestExitStatus(t *testing.T) {
+	pathErr := &fs.PathError{Op: "open", Path: "timings.json", Err: fs.ErrNotExist}
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "unexpected", err: errors.New("boom"), want: exitFailure},
+		{name: "git", err: fmt.Errorf("failed to diff: %w", &rippler.CommandError{Tool: rippler.ToolGit, Err: &exec.ExitError{}}), want: exitGit},
+		{name: "go", err: fmt.Errorf("failed to list: %w", &rippler.CommandError{Tool: rippler.ToolGo, Err: &exec.ExitError{}}), want: exitGoToolchain},
+		{name: "configuration", err: configError{errors.New("invalid rule")}, want: exitConfig},
+		{name: "package pattern", err: fmt.Errorf("invalid package patterns: %w", errors.Join(&rippler.PatternError{Pattern: "./nope"})), want: exitConfig},
+		{name: "file", err: fmt.Errorf("failed to load timings: %w", pathErr), want: exitIO},
+		{name: "unreadable configuration", err: configError{pathErr}, want: exitConfig},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitStatus(tt.err); got != tt.want {
+				t.Errorf("exitStatus() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsageStatus(t *testing.T) {
+	if got := usageStatus(errors.New("invalid output format")); got != exitUsage {
+		t.Errorf("usageStatus() = %d, want %d", got, exitUsage)
+	}
+
+	if got := usageStatus(fmt.Errorf("failed to read template: %w", &fs.PathError{Op: "open", Err: fs.ErrNotExist})); got != exitIO {
+		t.Errorf("usageStatus() = %d, want %d", got, exitIO)
+	}
+}
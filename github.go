@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/config"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+	"github.com/tangelo-labs/go-ripple/internal/rippler/printers"
+)
+
+// githubEvent holds the fields of the GitHub Actions event payload telling the base revision.
+type githubEvent struct {
+	// Before is the commit the branch pointed to before a push.
+	Before string `json:"before"`
+
+	PullRequest struct {
+		Base struct {
+			SHA string `json:"sha"`
+		} `json:"base"`
+	} `json:"pull_request"`
+}
+
+// githubActions reports whether the results are published to GitHub Actions: as requested
+// by the --github-actions flag or, when not given, whenever the tool runs in a GitHub Actions
+// workflow.
+func githubActions(args *Arguments) bool {
+	if args.GitHubActions != nil {
+		return *args.GitHubActions
+	}
+
+	return os.Getenv("GITHUB_ACTIONS") == "true"
+}
+
+// applyGitHubBase picks the base from the GitHub Actions environment, unless given on the
+// command line or configured: the target branch of pull requests, or the commit a push
+// started from. Outside of GitHub Actions, the environment variables it reads are not set.
+func applyGitHubBase(cfg *config.Config, args *AnalysisArguments) error {
+	if args.Base != "" || cfg.Base != "" {
+		return nil
+	}
+
+	if ref := os.Getenv("GITHUB_BASE_REF"); ref != "" {
+		cfg.Base = "origin/" + ref
+
+		return nil
+	}
+
+	path := os.Getenv("GITHUB_EVENT_PATH")
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read GitHub event payload: %w", err)
+	}
+
+	var event githubEvent
+	if uErr := json.Unmarshal(data, &event); uErr != nil {
+		return fmt.Errorf("failed to parse GitHub event payload: %w", uErr)
+	}
+
+	switch {
+	case event.PullRequest.Base.SHA != "":
+		cfg.Base = event.PullRequest.Base.SHA
+	case event.Before != "" && strings.Trim(event.Before, "0") != "":
+		// Pushes creating a branch have no previous commit, reported as all zeroes.
+		cfg.Base = event.Before
+	}
+
+	return nil
+}
+
+// writeGitHubActions publishes the report to the GitHub Actions workflow: the affected
+// packages, the test matrix and whether any package is affected as step outputs, and a
+// markdown summary appended to the job summary.
+func writeGitHubActions(report *rippler.Report, args *OutputArguments) error {
+	if path := os.Getenv("GITHUB_OUTPUT"); path != "" {
+		outputs, err := githubOutputs(report, args)
+		if err != nil {
+			return err
+		}
+
+		if aErr := appendFile(path, outputs); aErr != nil {
+			return fmt.Errorf("failed to write GitHub outputs: %w", aErr)
+		}
+	}
+
+	if path := os.Getenv("GITHUB_STEP_SUMMARY"); path != "" {
+		summary := bytes.Buffer{}
+		if err := printers.NewMarkdownPrinter().Print(&summary, report); err != nil {
+			return fmt.Errorf("failed to render GitHub step summary: %w", err)
+		}
+
+		if aErr := appendFile(path, summary.Bytes()); aErr != nil {
+			return fmt.Errorf("failed to write GitHub step summary: %w", aErr)
+		}
+	}
+
+	return nil
+}
+
+// githubOutputs renders the step outputs, one "name=value" line each, values being compact JSON.
+func githubOutputs(report *rippler.Report, args *OutputArguments) ([]byte, error) {
+	packages, err := json.Marshal(runTargets(report, false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal affected packages: %w", err)
+	}
+
+	printer, err := newTestMatrixPrinter(args)
+	if err != nil {
+		return nil, err
+	}
+
+	matrix := bytes.Buffer{}
+	if pErr := printer.Print(&matrix, report); pErr != nil {
+		return nil, fmt.Errorf("failed to render test matrix: %w", pErr)
+	}
+
+	compact := bytes.Buffer{}
+	if cErr := json.Compact(&compact, matrix.Bytes()); cErr != nil {
+		return nil, fmt.Errorf("failed to compact test matrix: %w", cErr)
+	}
+
+	out := bytes.Buffer{}
+	fmt.Fprintf(&out, "affected_packages=%s\n", packages)
+	fmt.Fprintf(&out, "matrix=%s\n", compact.String())
+	fmt.Fprintf(&out, "has_changes=%s\n", strconv.FormatBool(len(runTargets(report, false)) > 0))
+
+	return out.Bytes(), nil
+}
+
+// appendFile appends data to the file at path, creating it if needed.
+func appendFile(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, wErr := f.Write(data); wErr != nil {
+		_ = f.Close()
+
+		return wErr
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/config"
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+	"github.com/tangelo-labs/go-ripple/internal/rippler/printers"
+)
+
+func TestApplyGitHubBase(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    string
+		cfgBase string
+		baseRef string
+		event   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "pull request base ref",
+			baseRef: "main",
+			event:   `{"pull_request":{"base":{"sha":"abc123"}}}`,
+			want:    "origin/main",
+		},
+		{
+			name:  "pull request event",
+			event: `{"pull_request":{"base":{"sha":"abc123"}}}`,
+			want:  "abc123",
+		},
+		{
+			name:  "push event",
+			event: `{"before":"def456"}`,
+			want:  "def456",
+		},
+		{
+			name:  "push creating a branch",
+			event: `{"before":"0000000000000000000000000000000000000000"}`,
+		},
+		{
+			name:    "base flag",
+			base:    "origin/develop",
+			baseRef: "main",
+		},
+		{
+			name:    "configured base",
+			cfgBase: "origin/develop",
+			baseRef: "main",
+			want:    "origin/develop",
+		},
+		{
+			name:    "malformed event",
+			event:   `{`,
+			wantErr: true,
+		},
+		{
+			name: "outside of GitHub Actions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GITHUB_BASE_REF", tt.baseRef)
+			t.Setenv("GITHUB_EVENT_PATH", "")
+
+			if tt.event != "" {
+				path := filepath.Join(t.TempDir(), "event.json")
+				if err := os.WriteFile(path, []byte(tt.event), 0o644); err != nil {
+					t.Fatal(err)
+				}
+
+				t.Setenv("GITHUB_EVENT_PATH", path)
+			}
+
+			cfg := &config.Config{Base: tt.cfgBase}
+
+			err := applyGitHubBase(cfg, &AnalysisArguments{Base: tt.base})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyGitHubBase() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if cfg.Base != tt.want {
+				t.Errorf("applyGitHubBase() base = %q, want %q", cfg.Base, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitHubActions(t *testing.T) {
+	enabled, disabled := true, false
+
+	t.Setenv("GITHUB_ACTIONS", "true")
+
+	if !githubActions(&Arguments{}) {
+		t.Error("githubActions() = false within GitHub Actions, want true")
+	}
+
+	if githubActions(&Arguments{GitHubActions: &disabled}) {
+		t.Error("githubActions() = true with --github-actions=false, want false")
+	}
+
+	t.Setenv("GITHUB_ACTIONS", "")
+
+	if !githubActions(&Arguments{GitHubActions: &enabled}) {
+		t.Error("githubActions() = false with --github-actions, want true")
+	}
+}
+
+func TestWriteGitHubActions(t *testing.T) {
+	const module = "example.com/project"
+
+	tests := []struct {
+		name        string
+		affected    []model.AffectedPackage
+		wantOutputs string
+	}{
+		{
+			name: "affected packages",
+			affected: []model.AffectedPackage{
+				{ImportPath: module + "/api"},
+				{ImportPath: "github.com/x/y", Indirect: true},
+				{ImportPath: module + "/users"},
+			},
+			wantOutputs: `affected_packages=["example.com/project/api","example.com/project/users"]` + "\n" +
+				`matrix={"include":[{"name":"shard-1","packages":["example.com/project/api","example.com/project/users"]}]}` + "\n" +
+				"has_changes=true\n",
+		},
+		{
+			name:        "no affected packages",
+			wantOutputs: "affected_packages=[]\nmatrix={\"include\":[]}\nhas_changes=false\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := &rippler.Report{
+				GoMod:         model.GoMod{Module: model.GoModDependency{Path: module}},
+				RepositoryDir: "/repo",
+				ModuleDir:     "/repo",
+				AllPackages: []model.Package{
+					{ImportPath: module + "/users", Dir: "/repo/users"},
+					{ImportPath: module + "/api", Dir: "/repo/api"},
+				},
+				AffectedPackages: tt.affected,
+			}
+
+			dir := t.TempDir()
+			outputPath := filepath.Join(dir, "output")
+			summaryPath := filepath.Join(dir, "summary")
+
+			// Both files are shared by the steps of a job, so they are appended to.
+			for _, path := range []string{outputPath, summaryPath} {
+				if err := os.WriteFile(path, []byte("previous\n"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			t.Setenv("GITHUB_OUTPUT", outputPath)
+			t.Setenv("GITHUB_STEP_SUMMARY", summaryPath)
+
+			if err := writeGitHubActions(report, &OutputArguments{Shards: 1}); err != nil {
+				t.Fatalf("writeGitHubActions() error = %v", err)
+			}
+
+			outputs, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if want := "previous\n" + tt.wantOutputs; string(outputs) != want {
+				t.Errorf("GITHUB_OUTPUT =\n%s\nwant\n%s", outputs, want)
+			}
+
+			summary, err := os.ReadFile(summaryPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			markdown := bytes.Buffer{}
+			if pErr := printers.NewMarkdownPrinter().Print(&markdown, report); pErr != nil {
+				t.Fatal(pErr)
+			}
+
+			if want := "previous\n" + markdown.String(); string(summary) != want {
+				t.Errorf("GITHUB_STEP_SUMMARY =\n%s\nwant\n%s", summary, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"os"
+
+	"github.com/tangelo-labs/go-ripple/internal/config"
+)
+
+// applyGitLabBase picks the base from the GitLab CI environment, unless given on the command
+// line or configured: the merge request diff base, i.e. the merge base with the target branch.
+func applyGitLabBase(cfg *config.Config, args *AnalysisArguments) {
+	if args.Base != "" || cfg.Base != "" {
+		return
+	}
+
+	if sha := os.Getenv("CI_MERGE_REQUEST_DIFF_BASE_SHA"); sha != "" {
+		cfg.Base = sha
+	}
+}
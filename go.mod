@@ -2,6 +2,9 @@ module github.com/tangelo-labs/go-ripple
 
 go 1.25.0
 
-require github.com/alexflint/go-arg v1.6.0
+require (
+	github.com/alexflint/go-arg v1.6.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require github.com/alexflint/go-scalar v1.2.0 // indirect
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+
+	"github.com/alexflint/go-arg"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+// ImpactArguments holds the command line arguments for the "impact" command.
+type ImpactArguments struct {
+	Path   string `arg:"-C,--path" placeholder:"PATH" help:"The path to the Go project directory (holding a go.mod file). Defaults to the current directory if not specified." default:"."`
+	Config string `arg:"--config" placeholder:"FILE" help:"Path to the configuration file. Defaults to .go-ripple.yaml at the module root or the repository root."`
+
+	OutputArguments
+
+	Targets []string `arg:"positional,required" placeholder:"PACKAGE-OR-FILE" help:"The packages (import paths, or paths relative to the module root, optionally ending with '/...') or files to consider changed."`
+}
+
+// Description returns the description of the "impact" command, shown in its help text.
+func (ImpactArguments) Description() string {
+	return "Computes the blast radius of changing the given packages or files, without looking at\n" +
+		"Git history nor go.mod changes, e.g.:\n\n" +
+		"  go-ripple impact -o explain ./internal/auth internal/db/schema.go\n\n" +
+		"Files are given relative to the current directory. The configured rules, package filters,\n" +
+		"applications and artifacts apply as usual.\n"
+}
+
+// impactCommand implements "go-ripple impact [flags] package-or-file...".
+func impactCommand(args []string) {
+	var cmdArgs ImpactArguments
+
+	parser, err := arg.NewParser(arg.Config{Program: "go-ripple impact"}, &cmdArgs)
+	if err != nil {
+		fatal(exitFailure, "Failed to initialize argument parser: %v\n", err)
+	}
+
+	parser.MustParse(args)
+
+	cfg, err := loadConfig(cmdArgs.Path, cmdArgs.Config)
+	if err != nil {
+		fatal(exitConfig, "Failed to load configuration: %v\n", err)
+	}
+
+	outputs, err := newOutputs(cmdArgs.OutputFormat, firstNonEmpty(cfg.Output, defaultOutputFormat), &cmdArgs.OutputArguments)
+	if err != nil {
+		fatal(usageStatus(err), "%v\n", err)
+	}
+
+	rip, err := rippler.NewRippler("", cmdArgs.Path, ripplerOptions(cfg)...)
+	if err != nil {
+		fatal(exitConfig, "Failed to initialize rippler: %v\n", err)
+	}
+
+	report, err := rip.Impact(context.TODO(), cmdArgs.Targets)
+	if err != nil {
+		fatal(exitStatus(err), "Failed to compute impact: %v\n", err)
+	}
+
+	if wErr := writeOutputs(report, outputs); wErr != nil {
+		fatal(exitStatus(wErr), "%v\n", wErr)
+	}
+}
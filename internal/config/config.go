@@ -0,0 +1,236 @@
+// Package config loads the optional project configuration file (.go-ripple.yaml)
+// that lets a repository declare its go-ripple settings once, instead of repeating
+// them in every pipeline invocation.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/glob"
+	"gopkg.in/yaml.v3"
+)
+
+// FileNames are the configuration file names looked up, in order of preference.
+var FileNames = []string{".go-ripple.yaml", ".go-ripple.yml"}
+
+// Config holds the settings read from a project configuration file. Any value
+// given on the command line takes precedence over the one set here.
+type Config struct {
+	// Path is the absolute path of the file this configuration was read from.
+	// It is empty when no configuration file was found.
+	Path string `yaml:"-"`
+
+	// Base is the commit or branch to compare against, e.g. "origin/main".
+	Base string `yaml:"base"`
+
+	// Output is the output format to use, e.g. "json".
+	Output string `yaml:"output"`
+
+	// Ignore is a list of path patterns of changed files that must not be
+	// considered as package changes, e.g. "docs/" or "*.md".
+	Ignore []string `yaml:"ignore"`
+
+	// IgnoreGenerated makes changes to generated Go files non-triggering, as long as
+	// their generator input (any other file in the same directory) did not change.
+	IgnoreGenerated bool `yaml:"ignoreGenerated"`
+
+	// Rules maps changed files to the packages they affect. This is mostly useful
+	// for non-Go files, such as SQL migrations or embedded templates.
+	Rules []Rule `yaml:"rules"`
+
+	// GlobalTriggers is a list of path patterns that, when any changed file matches
+	// them, cause every package of the project to be considered affected.
+	GlobalTriggers []string `yaml:"globalTriggers"`
+
+	// Include is a list of package patterns that are always reported as affected.
+	Include []string `yaml:"include"`
+
+	// Exclude is a list of package patterns that are never reported as affected.
+	Exclude []string `yaml:"exclude"`
+
+	// MaxDepth limits the propagation to packages at most that many imports away from
+	// a direct change. Zero means no limit.
+	MaxDepth int `yaml:"maxDepth"`
+
+	// Applications declares the applications of the project, used to group affected
+	// packages. When empty, every main package under a "cmd" directory is an application.
+	Applications []Application `yaml:"applications"`
+
+	// Artifacts declares the deployable artifacts of the project (services, container
+	// images, Helm releases...), reported by the artifacts output when affected.
+	Artifacts []Artifact `yaml:"artifacts"`
+}
+
+// Artifact declares a deployable artifact and what it is built from.
+type Artifact struct {
+	// Name is the name of the artifact, e.g. "billing".
+	Name string `yaml:"name"`
+
+	// Kind is the kind of artifact, e.g. "service", "image" or "helm".
+	Kind string `yaml:"kind"`
+
+	// Packages is a list of package patterns the artifact is built from, e.g. "./cmd/billing".
+	Packages []string `yaml:"packages"`
+
+	// Files is a list of path patterns the artifact is built from, e.g. "deploy/billing/**".
+	Files []string `yaml:"files"`
+}
+
+// Application declares an application made of the packages matching the given patterns.
+type Application struct {
+	// Name is the application name. Defaults to the last element of the first pattern.
+	Name string `yaml:"name"`
+
+	// Packages is a list of package patterns, such as "./services/billing/...".
+	Packages []string `yaml:"packages"`
+}
+
+// Rule declares that changes to any file matching Files affect Packages.
+type Rule struct {
+	// Name is an optional human-readable name used when explaining changes.
+	Name string `yaml:"name"`
+
+	// Files is a list of path patterns, relative to the repository root.
+	Files []string `yaml:"files"`
+
+	// Packages is a list of package patterns, either import paths or paths
+	// relative to the module root such as "./internal/db" or "./internal/...".
+	Packages []string `yaml:"packages"`
+}
+
+// Find looks for a configuration file in each of the given directories, in order,
+// and returns the path of the first one found. It returns an empty string if
+// there is none.
+func Find(dirs ...string) string {
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+
+		for _, name := range FileNames {
+			candidate := filepath.Join(dir, name)
+
+			if st, err := os.Stat(candidate); err == nil && !st.IsDir() {
+				return candidate
+			}
+		}
+	}
+
+	return ""
+}
+
+// Load reads and decodes the configuration file at the given path. Unknown keys
+// are reported as errors so typos do not go unnoticed.
+func Load(path string) (*Config, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get absolute path for %s: %w", path, err)
+	}
+
+	data, err := os.ReadFile(abs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+
+	cfg := &Config{}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if dErr := decoder.Decode(cfg); dErr != nil && !errors.Is(dErr, io.EOF) {
+		return nil, fmt.Errorf("failed to decode configuration file %s: %w", abs, dErr)
+	}
+
+	cfg.Path = abs
+
+	return cfg, nil
+}
+
+// Validate checks the configuration for semantic errors, such as malformed
+// patterns or incomplete rules. All problems found are reported at once.
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.MaxDepth < 0 {
+		errs = append(errs, fmt.Errorf("maxDepth: must not be negative, got %d", c.MaxDepth))
+	}
+
+	for i := range c.Ignore {
+		if err := glob.Validate(c.Ignore[i]); err != nil {
+			errs = append(errs, fmt.Errorf("ignore[%d]: %w", i, err))
+		}
+	}
+
+	for i := range c.GlobalTriggers {
+		if err := glob.Validate(c.GlobalTriggers[i]); err != nil {
+			errs = append(errs, fmt.Errorf("globalTriggers[%d]: %w", i, err))
+		}
+	}
+
+	for i := range c.Rules {
+		if len(c.Rules[i].Files) == 0 {
+			errs = append(errs, fmt.Errorf("rules[%d]: no files given", i))
+		}
+
+		if len(c.Rules[i].Packages) == 0 {
+			errs = append(errs, fmt.Errorf("rules[%d]: no packages given", i))
+		}
+
+		for j := range c.Rules[i].Files {
+			if err := glob.Validate(c.Rules[i].Files[j]); err != nil {
+				errs = append(errs, fmt.Errorf("rules[%d].files[%d]: %w", i, j, err))
+			}
+		}
+
+		errs = append(errs, validatePackagePatterns(fmt.Sprintf("rules[%d].packages", i), c.Rules[i].Packages)...)
+	}
+
+	for i := range c.Applications {
+		if len(c.Applications[i].Packages) == 0 {
+			errs = append(errs, fmt.Errorf("applications[%d]: no packages given", i))
+		}
+
+		errs = append(errs, validatePackagePatterns(fmt.Sprintf("applications[%d].packages", i), c.Applications[i].Packages)...)
+	}
+
+	for i := range c.Artifacts {
+		if strings.TrimSpace(c.Artifacts[i].Name) == "" {
+			errs = append(errs, fmt.Errorf("artifacts[%d]: no name given", i))
+		}
+
+		if len(c.Artifacts[i].Packages) == 0 && len(c.Artifacts[i].Files) == 0 {
+			errs = append(errs, fmt.Errorf("artifacts[%d]: no packages nor files given", i))
+		}
+
+		for j := range c.Artifacts[i].Files {
+			if err := glob.Validate(c.Artifacts[i].Files[j]); err != nil {
+				errs = append(errs, fmt.Errorf("artifacts[%d].files[%d]: %w", i, j, err))
+			}
+		}
+
+		errs = append(errs, validatePackagePatterns(fmt.Sprintf("artifacts[%d].packages", i), c.Artifacts[i].Packages)...)
+	}
+
+	errs = append(errs, validatePackagePatterns("include", c.Include)...)
+	errs = append(errs, validatePackagePatterns("exclude", c.Exclude)...)
+
+	return errors.Join(errs...)
+}
+
+func validatePackagePatterns(field string, patterns []string) []error {
+	var errs []error
+
+	for i := range patterns {
+		if strings.TrimSpace(patterns[i]) == "" {
+			errs = append(errs, fmt.Errorf("%s[%d]: empty package pattern", field, i))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+
+		// wantErrs are the fragments expected in the error, none meaning a valid configuration.
+		wantErrs []string
+	}{
+		{
+			name: "empty",
+			cfg:  Config{},
+		},
+		{
+			name: "valid",
+			cfg: Config{
+				Ignore:         []string{"docs/", "*.md"},
+				GlobalTriggers: []string{"Makefile", ".github/**"},
+				Rules:          []Rule{{Files: []string{"migrations/**/*.sql"}, Packages: []string{"./internal/db"}}},
+				Include:        []string{"./cmd/..."},
+				MaxDepth:       2,
+				Applications:   []Application{{Name: "api", Packages: []string{"./cmd/api"}}},
+				Artifacts:      []Artifact{{Name: "chart", Files: []string{"deploy/chart/"}}},
+			},
+		},
+		{
+			name:     "negative max depth",
+			cfg:      Config{MaxDepth: -1},
+			wantErrs: []string{"maxDepth: must not be negative"},
+		},
+		{
+			name:     "malformed ignore pattern",
+			cfg:      Config{Ignore: []string{"docs/", "[a.md"}},
+			wantErrs: []string{"ignore[1]"},
+		},
+		{
+			name:     "empty global trigger",
+			cfg:      Config{GlobalTriggers: []string{""}},
+			wantErrs: []string{"globalTriggers[0]: empty pattern"},
+		},
+		{
+			name:     "incomplete rule",
+			cfg:      Config{Rules: []Rule{{Name: "sql"}}},
+			wantErrs: []string{"rules[0]: no files given", "rules[0]: no packages given"},
+		},
+		{
+			name:     "malformed rule file pattern",
+			cfg:      Config{Rules: []Rule{{Files: []string{"[x"}, Packages: []string{"./db"}}}},
+			wantErrs: []string{"rules[0].files[0]"},
+		},
+		{
+			name:     "empty package patterns",
+			cfg:      Config{Include: []string{" "}, Exclude: []string{"./a", ""}},
+			wantErrs: []string{"include[0]: empty package pattern", "exclude[1]: empty package pattern"},
+		},
+		{
+			name:     "application without packages",
+			cfg:      Config{Applications: []Application{{Name: "api"}}},
+			wantErrs: []string{"applications[0]: no packages given"},
+		},
+		{
+			name:     "artifact without name nor sources",
+			cfg:      Config{Artifacts: []Artifact{{Kind: "image"}}},
+			wantErrs: []string{"artifacts[0]: no name given", "artifacts[0]: no packages nor files given"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want none", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("Validate() error = nil, want %q", tt.wantErrs)
+			}
+
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Validate() error = %v, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Package glob implements the path patterns used to select changed files, such
+// as ignore patterns, file-to-package rules and global triggers.
+//
+// Patterns are slash-separated and matched against slash-separated paths:
+//
+//   - "*", "?" and "[...]" behave as in path.Match and never cross a "/".
+//   - "**" as a whole segment matches zero or more segments.
+//   - A pattern without any "/" matches the base name at any depth, so "*.md"
+//     matches both "README.md" and "docs/guide/intro.md".
+//   - A pattern ending in "/" matches everything below that directory.
+package glob
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Match reports whether name matches the given pattern. Malformed patterns
+// never match, use Validate to detect them beforehand.
+func Match(pattern, name string) bool {
+	pattern = normalize(pattern)
+	name = strings.TrimPrefix(name, "./")
+
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// MatchAny returns the first pattern matching name, and whether there was one.
+func MatchAny(patterns []string, name string) (string, bool) {
+	for i := range patterns {
+		if Match(patterns[i], name) {
+			return patterns[i], true
+		}
+	}
+
+	return "", false
+}
+
+// Validate checks that the given pattern is well-formed.
+func Validate(pattern string) error {
+	if strings.TrimSpace(pattern) == "" {
+		return fmt.Errorf("empty pattern")
+	}
+
+	for _, segment := range strings.Split(normalize(pattern), "/") {
+		if segment == "**" {
+			continue
+		}
+
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+func normalize(pattern string) string {
+	pattern = strings.TrimPrefix(pattern, "./")
+
+	if strings.HasSuffix(pattern, "/") {
+		return pattern + "**"
+	}
+
+	if !strings.Contains(pattern, "/") {
+		return "**/" + pattern
+	}
+
+	return pattern
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, err := path.Match(pattern[0], name[0]); err != nil || !ok {
+			return false
+		}
+
+		pattern = pattern[1:]
+		name = name[1:]
+	}
+
+	return len(name) == 0
+}
@@ -0,0 +1,89 @@
+package glob
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		path    string
+		want    bool
+	}{
+		{name: "base name at root", pattern: "*.md", path: "README.md", want: true},
+		{name: "base name at any depth", pattern: "*.md", path: "docs/guide/intro.md", want: true},
+		{name: "base name mismatch", pattern: "*.md", path: "docs/guide/intro.txt", want: false},
+		{name: "star does not cross slashes", pattern: "docs/*.md", path: "docs/guide/intro.md", want: false},
+		{name: "anchored at the root", pattern: "docs/*.md", path: "docs/index.md", want: true},
+		{name: "anchored pattern deeper", pattern: "docs/*.md", path: "site/docs/index.md", want: false},
+		{name: "leading dot slash", pattern: "./docs/*.md", path: "docs/index.md", want: true},
+		{name: "leading dot slash in path", pattern: "docs/*.md", path: "./docs/index.md", want: true},
+		{name: "directory prefix", pattern: "docs/", path: "docs/guide/intro.md", want: true},
+		{name: "directory prefix mismatch", pattern: "docs/", path: "documentation/intro.md", want: false},
+		{name: "double star zero segments", pattern: "migrations/**/*.sql", path: "migrations/001.sql", want: true},
+		{name: "double star several segments", pattern: "migrations/**/*.sql", path: "migrations/a/b/001.sql", want: true},
+		{name: "double star mismatch", pattern: "migrations/**/*.sql", path: "migrations/a/b/001.go", want: false},
+		{name: "double star leading", pattern: "**/testdata/*", path: "a/b/testdata/in.json", want: true},
+		{name: "double star trailing", pattern: "deploy/**", path: "deploy/billing/values.yaml", want: true},
+		{name: "question mark", pattern: "v?.txt", path: "v1.txt", want: true},
+		{name: "character class", pattern: "[ab].go", path: "a.go", want: true},
+		{name: "negated character class", pattern: "[^ab].go", path: "a.go", want: false},
+		{name: "negated character class match", pattern: "[^ab].go", path: "c.go", want: true},
+		{name: "exclamation mark is literal", pattern: "[!ab].go", path: "c.go", want: false},
+		{name: "malformed pattern", pattern: "[a.go", path: "a.go", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Match(tt.pattern, tt.path); got != tt.want {
+				t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchAny(t *testing.T) {
+	patterns := []string{"docs/", "*.md"}
+
+	tests := []struct {
+		path    string
+		want    string
+		matched bool
+	}{
+		{path: "docs/README.md", want: "docs/", matched: true},
+		{path: "pkg/README.md", want: "*.md", matched: true},
+		{path: "pkg/main.go", want: "", matched: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, matched := MatchAny(patterns, tt.path)
+			if got != tt.want || matched != tt.matched {
+				t.Errorf("MatchAny(%q) = %q, %v, want %q, %v", tt.path, got, matched, tt.want, tt.matched)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		pattern string
+		wantErr bool
+	}{
+		{pattern: "*.md", wantErr: false},
+		{pattern: "docs/", wantErr: false},
+		{pattern: "migrations/**/*.sql", wantErr: false},
+		{pattern: "[^a-z].go", wantErr: false},
+		{pattern: "", wantErr: true},
+		{pattern: "  ", wantErr: true},
+		{pattern: "[a.go", wantErr: true},
+		{pattern: "docs/[/x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			if err := Validate(tt.pattern); (err != nil) != tt.wantErr {
+				t.Errorf("Validate(%q) error = %v, want error %v", tt.pattern, err, tt.wantErr)
+			}
+		})
+	}
+}
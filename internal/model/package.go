@@ -8,9 +8,18 @@ type Package struct {
 	// GoFiles are the Go source files in the package, relative to Dir.
 	GoFiles []string
 
+	// TestGoFiles are the (internal) test files in the package, relative to Dir.
+	TestGoFiles []string
+
+	// XTestGoFiles are the (external) test files in the package, relative to Dir.
+	XTestGoFiles []string
+
 	// ImportPath is the import path of the package, e.g. "github.com/me/project/users".
 	ImportPath string
 
+	// Name is the package name, e.g. "users" or "main" for executables.
+	Name string
+
 	// Imports is the list of import paths used by this package.
 	Imports []string
 
@@ -22,13 +31,42 @@ type Package struct {
 
 	// Deps are the module dependencies of the package.
 	Deps []string
+
+	// Module is the module holding the package, nil for standard library packages.
+	Module *PackageModule
+}
+
+// PackageModule represents the module of a package, as reported by `go list -json`.
+type PackageModule struct {
+	// Path is the module path, e.g. "github.com/me/project".
+	Path string
+
+	// Dir is the absolute path to the module directory.
+	Dir string
+
+	// Main tells whether the module is a main module, i.e. part of the workspace.
+	Main bool
 }
 
-// AffectedPackage represents a package that is affected by a change.
+// AffectedPackage represents a package that is affected by a change. The propagation details
+// (Distance, Parents and Origin) are left out of its JSON form, so the json output keeps its
+// shape; the report-json output carries them instead.
 type AffectedPackage struct {
 	// ImportPath is the import path of the package, e.g. "github.com/me/project/users".
 	ImportPath string
 
 	// Indirect indicates whether the package is an indirect dependency.
 	Indirect bool
+
+	// Distance is the number of imports between the package and the nearest direct change,
+	// zero for directly changed packages.
+	Distance int `json:"-"`
+
+	// Parents are the import paths of the packages, one hop closer to a direct change, whose
+	// change pulled this package in. Empty for directly changed packages.
+	Parents []string `json:"-"`
+
+	// Origin is the name of the direct change (see rippler.Change) this package is affected by,
+	// through the nearest chain of imports. Empty for packages included by configuration.
+	Origin string `json:"-"`
 }
@@ -0,0 +1,113 @@
+package rippler
+
+import (
+	"path"
+	"slices"
+	"strings"
+)
+
+// Application represents a program built from the project, such as a service or a CLI,
+// along with the affected packages it depends on.
+type Application struct {
+	// Name is the name of the application, e.g. "billing".
+	Name string
+
+	// Roots are the import paths of the packages the application is made of, usually a
+	// single main package.
+	Roots []string
+
+	// AffectedPackages are the affected packages the application depends on, including
+	// its own roots when they are affected, sorted by import path.
+	AffectedPackages []string
+}
+
+// ApplicationRoot declares an application made of the packages matching the given patterns.
+type ApplicationRoot struct {
+	// Name is the application name. Defaults to the last element of the first pattern.
+	Name string
+
+	// Packages is a list of package patterns, either import paths or paths relative to
+	// the module root such as "./services/billing/...".
+	Packages []string
+}
+
+// String returns the application name, or the one derived from its first pattern when unnamed.
+func (a ApplicationRoot) String() string {
+	if a.Name != "" || len(a.Packages) == 0 {
+		return a.Name
+	}
+
+	return path.Base(strings.TrimSuffix(a.Packages[0], "/..."))
+}
+
+// affectedApplications groups the affected packages by the applications depending on them.
+// Applications are either the configured roots or, when none is configured, every main
+// package living under a "cmd" directory. Applications not depending on any affected package
+// are left out.
+func (r *Rippler) affectedApplications(report *Report) []Application {
+	affected := make(map[string]struct{})
+	for i := range report.AffectedPackages {
+		affected[report.AffectedPackages[i].ImportPath] = struct{}{}
+	}
+
+	out := make([]Application, 0)
+
+	for _, app := range r.applicationRoots(report) {
+		roots := r.expandPackagePatterns(report, app.Packages)
+		deps := make(map[string]struct{})
+
+		for i := range report.AllPackages {
+			if !slices.Contains(roots, report.AllPackages[i].ImportPath) {
+				continue
+			}
+
+			deps[report.AllPackages[i].ImportPath] = struct{}{}
+
+			for _, dep := range report.AllPackages[i].Deps {
+				deps[dep] = struct{}{}
+			}
+		}
+
+		application := Application{Name: app.String(), Roots: roots}
+
+		for dep := range deps {
+			if _, ok := affected[dep]; ok {
+				application.AffectedPackages = append(application.AffectedPackages, dep)
+			}
+		}
+
+		if len(application.AffectedPackages) == 0 {
+			continue
+		}
+
+		slices.Sort(application.AffectedPackages)
+		out = append(out, application)
+	}
+
+	return out
+}
+
+// applicationRoots returns the configured application roots, or detects them as the main
+// packages living under a "cmd" directory.
+func (r *Rippler) applicationRoots(report *Report) []ApplicationRoot {
+	if len(r.applications) > 0 {
+		return r.applications
+	}
+
+	out := make([]ApplicationRoot, 0)
+
+	for i := range report.AllPackages {
+		pkg := report.AllPackages[i]
+
+		if pkg.Name != "main" || !slices.Contains(strings.Split(pkg.ImportPath, "/"), "cmd") {
+			continue
+		}
+
+		out = append(out, ApplicationRoot{
+			Name:     path.Base(pkg.ImportPath),
+			Packages: []string{pkg.ImportPath},
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,76 @@
+package rippler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+)
+
+func TestAffectedApplications(t *testing.T) {
+	withDeps := func(pkg model.Package, name string, deps ...string) model.Package {
+		pkg.Name = name
+
+		for _, dep := range deps {
+			pkg.Deps = append(pkg.Deps, testImportPath(dep))
+		}
+
+		return pkg
+	}
+
+	pkgs := []model.Package{
+		testPackage("internal/db"),
+		testPackage("internal/cache"),
+		withDeps(testPackage("services/billing"), "billing", "internal/db"),
+		withDeps(testPackage("cmd/api"), "main", "internal/db"),
+		withDeps(testPackage("cmd/worker"), "main", "internal/cache"),
+		withDeps(testPackage("tools/gen"), "main", "internal/db"),
+	}
+
+	report := withAffected(newTestReport(pkgs), "internal/db", "services/billing", "cmd/api", "tools/gen")
+
+	tests := []struct {
+		name         string
+		applications []ApplicationRoot
+		want         []Application
+	}{
+		{
+			name: "main packages under cmd",
+			want: []Application{{
+				Name:             "api",
+				Roots:            []string{testImportPath("cmd/api")},
+				AffectedPackages: []string{testImportPath("cmd/api"), testImportPath("internal/db")},
+			}},
+		},
+		{
+			name: "configured roots",
+			applications: []ApplicationRoot{
+				{Packages: []string{"./services/..."}},
+				{Name: "workers", Packages: []string{"./cmd/worker"}},
+				{Name: "tools", Packages: []string{"./tools/gen", "./cmd/api"}},
+			},
+			want: []Application{
+				{
+					Name:             "services",
+					Roots:            []string{testImportPath("services/billing")},
+					AffectedPackages: []string{testImportPath("internal/db"), testImportPath("services/billing")},
+				},
+				{
+					Name:             "tools",
+					Roots:            []string{testImportPath("tools/gen"), testImportPath("cmd/api")},
+					AffectedPackages: []string{testImportPath("cmd/api"), testImportPath("internal/db"), testImportPath("tools/gen")},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Rippler{applications: tt.applications}
+
+			if got := r.affectedApplications(report); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("affectedApplications() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package rippler
+
+import (
+	"github.com/tangelo-labs/go-ripple/internal/glob"
+)
+
+// ArtifactRule declares a deployable artifact, such as a service, a container image or a
+// Helm release, and what it is built from.
+type ArtifactRule struct {
+	// Name is the name of the artifact, e.g. "billing".
+	Name string
+
+	// Kind is the kind of artifact, e.g. "service", "image" or "helm".
+	Kind string
+
+	// Packages is a list of package patterns the artifact is built from, usually main
+	// packages such as "./cmd/billing".
+	Packages []string
+
+	// Files is a list of path patterns, relative to the repository root, of files the
+	// artifact is built from, such as "deploy/billing/**".
+	Files []string
+}
+
+// Artifact represents an affected deployable artifact.
+type Artifact struct {
+	// Name is the name of the artifact, e.g. "billing".
+	Name string
+
+	// Kind is the kind of artifact, e.g. "service", "image" or "helm".
+	Kind string
+
+	// Chain is the shortest chain of package import paths that pulled the artifact in,
+	// from a directly changed package to one of the artifact packages. It is empty when
+	// only Files affected the artifact.
+	Chain []string
+
+	// Files are the absolute paths of the changed files matching the artifact files. Outputs
+	// show them relative to the repository root.
+	Files []string
+}
+
+// affectedArtifacts determines which of the configured artifacts are affected, either because
+// one of their packages is affected and reached by a (non-test) change through (non-test)
+// imports, or because one of their files changed.
+func (r *Rippler) affectedArtifacts(report *Report) []Artifact {
+	out := make([]Artifact, 0)
+
+	affected := make(map[string]struct{})
+	for i := range report.AffectedPackages {
+		affected[report.AffectedPackages[i].ImportPath] = struct{}{}
+	}
+
+	// Chains only start from the changes that may alter build outputs, see affectedBinaries.
+	built := *report
+	built.Changes = buildChanges(report.Changes)
+	edges := dependentEdges(report.AllPackages, false)
+
+	for _, rule := range r.artifacts {
+		artifact := Artifact{Name: rule.Name, Kind: rule.Kind}
+		found := false
+
+		for _, pkg := range r.expandPackagePatterns(report, rule.Packages) {
+			if _, ok := affected[pkg]; !ok {
+				continue
+			}
+
+			chain, ok := shortestChain(&built, edges, pkg)
+			if !ok || (found && len(chain)+1 >= len(artifact.Chain)) {
+				continue
+			}
+
+			found = true
+			artifact.Chain = chainPackages(chain, pkg)
+		}
+
+		for i := range report.ChangedFiles {
+			if _, ok := glob.MatchAny(rule.Files, r.relativeToRepository(report.ChangedFiles[i])); ok {
+				artifact.Files = append(artifact.Files, report.ChangedFiles[i])
+			}
+		}
+
+		if found || len(artifact.Files) > 0 {
+			out = append(out, artifact)
+		}
+	}
+
+	return out
+}
+
+// chainPackages turns a chain of import edges ending at the given package into the list
+// of the package import paths along it.
+func chainPackages(chain []ImportEdge, pkg string) []string {
+	out := make([]string, 0, len(chain)+1)
+
+	for i := range chain {
+		out = append(out, chain[i].Imported)
+	}
+
+	return append(out, pkg)
+}
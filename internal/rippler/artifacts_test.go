@@ -0,0 +1,77 @@
+package rippler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+)
+
+func TestAffectedArtifacts(t *testing.T) {
+	pkgs := []model.Package{
+		testPackage("pkg/strutil"),
+		testPackage("internal/db", "pkg/strutil"),
+		testPackage("cmd/api", "internal/db"),
+		testPackage("cmd/worker", "pkg/strutil"),
+		testPackage("cmd/tool"),
+	}
+
+	rules := []ArtifactRule{
+		{Name: "api", Kind: "service", Packages: []string{"./cmd/api"}},
+		{Name: "worker", Kind: "image", Packages: []string{"./cmd/worker"}, Files: []string{"deploy/worker/**"}},
+		{Name: "tool", Packages: []string{"./cmd/tool"}},
+	}
+
+	tests := []struct {
+		name    string
+		report  *Report
+		changed []string
+		want    []Artifact
+	}{
+		{
+			name:   "reached through imports",
+			report: withAffected(newTestReport(pkgs, fileChange("pkg/strutil/s.go")), "pkg/strutil", "internal/db", "cmd/api", "cmd/worker"),
+			want: []Artifact{
+				{Name: "api", Kind: "service", Chain: []string{
+					testImportPath("pkg/strutil"), testImportPath("internal/db"), testImportPath("cmd/api"),
+				}},
+				{Name: "worker", Kind: "image", Chain: []string{testImportPath("pkg/strutil"), testImportPath("cmd/worker")}},
+			},
+		},
+		{
+			name:   "excluded package",
+			report: withAffected(newTestReport(pkgs, fileChange("pkg/strutil/s.go")), "pkg/strutil", "internal/db", "cmd/worker"),
+			want: []Artifact{
+				{Name: "worker", Kind: "image", Chain: []string{testImportPath("pkg/strutil"), testImportPath("cmd/worker")}},
+			},
+		},
+		{
+			name: "test file change",
+			report: withAffected(newTestReport(pkgs, Change{
+				PackageName: testImportPath("pkg/strutil"),
+				Reasons:     []Reason{{Kind: ReasonTestFile, File: testRepository + "/pkg/strutil/s_test.go"}},
+			}), "pkg/strutil"),
+			want: []Artifact{},
+		},
+		{
+			name:    "artifact files",
+			report:  withAffected(newTestReport(pkgs)),
+			changed: []string{"deploy/worker/values.yaml", "deploy/api/values.yaml"},
+			want:    []Artifact{{Name: "worker", Kind: "image", Files: []string{testRepository + "/deploy/worker/values.yaml"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Rippler{repoRoot: testRepository, artifacts: rules}
+
+			for _, file := range tt.changed {
+				tt.report.ChangedFiles = append(tt.report.ChangedFiles, testRepository+"/"+file)
+			}
+
+			if got := r.affectedArtifacts(tt.report); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("affectedArtifacts() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package rippler
+
+import (
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Binary represents an affected executable, i.e. a main package whose build output may
+// differ because of the detected changes.
+type Binary struct {
+	// Name is the name of the executable, as produced by `go build`, e.g. "billing".
+	Name string
+
+	// ImportPath is the import path of the main package, e.g. "github.com/me/project/cmd/billing".
+	ImportPath string
+
+	// Dir is the directory of the main package, relative to the module root, e.g. "./cmd/billing".
+	Dir string
+
+	// Reasons are the reasons of the direct changes reaching this binary.
+	Reasons []Reason
+}
+
+// affectedBinaries determines which main packages are affected by the detected changes.
+// Unlike AffectedPackages, test imports are not followed: a binary is only affected when
+// one of its build dependencies, or the main package itself, changed.
+func (r *Rippler) affectedBinaries(report *Report) []Binary {
+	affected := make(map[string]struct{})
+	for i := range report.AffectedPackages {
+		affected[report.AffectedPackages[i].ImportPath] = struct{}{}
+	}
+
+	out := make([]Binary, 0)
+
+	for i := range report.AllPackages {
+		pkg := report.AllPackages[i]
+
+		if _, ok := affected[pkg.ImportPath]; !ok || pkg.Name != "main" {
+			continue
+		}
+
+		reasons := make([]Reason, 0)
+		build := append([]string{pkg.ImportPath}, pkg.Deps...)
+
+		for _, change := range buildChanges(report.Changes) {
+			if slices.ContainsFunc(build, func(dep string) bool {
+				return matchPackagePattern(report.GoMod.Module.Path, change.PackageName, dep)
+			}) {
+				reasons = append(reasons, change.Reasons...)
+			}
+		}
+
+		if len(reasons) == 0 {
+			continue
+		}
+
+		out = append(out, Binary{
+			Name:       path.Base(pkg.ImportPath),
+			ImportPath: pkg.ImportPath,
+			Dir:        moduleRelativeDir(report.ModuleDir, pkg.Dir),
+			Reasons:    reasons,
+		})
+	}
+
+	slices.SortFunc(out, func(a, b Binary) int {
+		return strings.Compare(a.ImportPath, b.ImportPath)
+	})
+
+	return out
+}
+
+// buildChanges returns the changes that may alter build outputs, i.e. the given changes
+// without their test file reasons, as test files are not part of the built binaries.
+// Changes left without any reason are dropped.
+func buildChanges(changes []Change) []Change {
+	out := make([]Change, 0, len(changes))
+
+	for _, change := range changes {
+		reasons := make([]Reason, 0, len(change.Reasons))
+
+		for _, reason := range change.Reasons {
+			if reason.Kind != ReasonTestFile {
+				reasons = append(reasons, reason)
+			}
+		}
+
+		if len(reasons) > 0 {
+			out = append(out, Change{PackageName: change.PackageName, Reasons: reasons})
+		}
+	}
+
+	return out
+}
+
+// moduleRelativeDir returns the given directory relative to the module root, in the
+// "./path/to/dir" form understood by the go command.
+func moduleRelativeDir(moduleDir, dir string) string {
+	rel, err := filepath.Rel(moduleDir, dir)
+	if err != nil {
+		return dir
+	}
+
+	if rel == "." {
+		return "."
+	}
+
+	return "./" + filepath.ToSlash(rel)
+}
@@ -0,0 +1,70 @@
+package rippler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+)
+
+func TestAffectedBinaries(t *testing.T) {
+	mainPackage := func(rel string, deps ...string) model.Package {
+		pkg := testPackage(rel, deps...)
+		pkg.Name = "main"
+
+		for _, dep := range deps {
+			pkg.Deps = append(pkg.Deps, testImportPath(dep))
+		}
+
+		return pkg
+	}
+
+	pkgs := []model.Package{
+		testPackage("internal/db"),
+		testPackage("internal/cache"),
+		mainPackage("cmd/api", "internal/db"),
+		mainPackage("cmd/tool", "internal/cache"),
+	}
+
+	file := Reason{Kind: ReasonFile, File: testRepository + "/internal/db/db.go"}
+	testFile := Reason{Kind: ReasonTestFile, File: testRepository + "/internal/db/db_test.go"}
+
+	tests := []struct {
+		name   string
+		report *Report
+		want   []Binary
+	}{
+		{
+			name: "build dependency changed",
+			report: withAffected(newTestReport(pkgs, Change{
+				PackageName: testImportPath("internal/db"),
+				Reasons:     []Reason{file, testFile},
+			}), "internal/db", "cmd/api"),
+			want: []Binary{{Name: "api", ImportPath: testImportPath("cmd/api"), Dir: "./cmd/api", Reasons: []Reason{file}}},
+		},
+		{
+			name: "only test files changed",
+			report: withAffected(newTestReport(pkgs, Change{
+				PackageName: testImportPath("internal/db"),
+				Reasons:     []Reason{testFile},
+			}), "internal/db", "cmd/api"),
+			want: []Binary{},
+		},
+		{
+			name: "not affected",
+			report: withAffected(newTestReport(pkgs, Change{
+				PackageName: testImportPath("internal/cache"),
+				Reasons:     []Reason{{Kind: ReasonFile, File: testRepository + "/internal/cache/cache.go"}},
+			}), "internal/cache"),
+			want: []Binary{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (&Rippler{}).affectedBinaries(tt.report); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("affectedBinaries() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package rippler
+
+import (
+	"fmt"
+)
+
+// Tool names an external program the analysis relies on.
+type Tool string
+
+const (
+	// ToolGit is the git command line.
+	ToolGit Tool = "git"
+
+	// ToolGo is the go command line.
+	ToolGo Tool = "go"
+)
+
+// CommandError reports the failure of an external command run during the analysis, so
+// callers can tell, e.g., a missing base revision from a broken Go module.
+type CommandError struct {
+	// Tool is the program that failed.
+	Tool Tool
+
+	// Err is the underlying error, usually an *exec.ExitError.
+	Err error
+}
+
+func (e *CommandError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CommandError) Unwrap() error {
+	return e.Err
+}
+
+// PatternError reports a package pattern, relative to the module or within it, that does
+// not match any package of the module, e.g. because of a typo in the configuration.
+type PatternError struct {
+	// Pattern is the package pattern, as given.
+	Pattern string
+}
+
+func (e *PatternError) Error() string {
+	return fmt.Sprintf("package pattern %s does not match any package", e.Pattern)
+}
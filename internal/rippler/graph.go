@@ -0,0 +1,254 @@
+package rippler
+
+import (
+	"slices"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+)
+
+// ImportKind tells which files of a package hold an import.
+type ImportKind string
+
+const (
+	// ImportRegular is an import from the package's non-test files.
+	ImportRegular ImportKind = "import"
+
+	// ImportTest is an import from the package's (internal) test files.
+	ImportTest ImportKind = "test import"
+
+	// ImportXTest is an import from the package's (external) test files.
+	ImportXTest ImportKind = "xtest import"
+)
+
+// ImportEdge links an imported package to one of the packages importing it.
+type ImportEdge struct {
+	// Imported is the import path of the imported package.
+	Imported string
+
+	// Importer is the import path of the package importing it.
+	Importer string
+
+	// Kind tells which files of the importer hold the import.
+	Kind ImportKind
+}
+
+// dependentEdges maps import paths to the edges towards the packages importing them.
+// A package importing another one from several kinds of files gets a single edge, the
+// regular import taking precedence over test imports. Test imports are only considered
+// when withTests is set.
+func dependentEdges(pkgs []model.Package, withTests bool) map[string][]ImportEdge {
+	out := make(map[string][]ImportEdge)
+
+	for i := range pkgs {
+		seen := make(map[string]struct{})
+
+		add := func(imports []string, kind ImportKind) {
+			for _, imported := range imports {
+				if _, ok := seen[imported]; ok {
+					continue
+				}
+
+				seen[imported] = struct{}{}
+				out[imported] = append(out[imported], ImportEdge{
+					Imported: imported,
+					Importer: pkgs[i].ImportPath,
+					Kind:     kind,
+				})
+			}
+		}
+
+		add(pkgs[i].Imports, ImportRegular)
+
+		if withTests {
+			add(pkgs[i].TestImports, ImportTest)
+			add(pkgs[i].XTestImports, ImportXTest)
+		}
+	}
+
+	return out
+}
+
+// ShortestChain returns the shortest chain of imports leading from any directly changed
+// package (see Report.Changes) to the target package. The chain is empty when the target
+// itself is directly changed, and the second return value is false when no change reaches
+// the target. Test imports are only followed when withTests is set.
+func ShortestChain(report *Report, target string, withTests bool) ([]ImportEdge, bool) {
+	return shortestChain(report, dependentEdges(report.AllPackages, withTests), target)
+}
+
+// shortestChain is ShortestChain over the given dependent edges, so callers looking for
+// several targets build them once.
+func shortestChain(report *Report, edges map[string][]ImportEdge, target string) ([]ImportEdge, bool) {
+	sources := make([]string, 0, len(report.Changes))
+
+	for i := range report.Changes {
+		if matchPackagePattern(report.GoMod.Module.Path, report.Changes[i].PackageName, target) {
+			return []ImportEdge{}, true
+		}
+
+		sources = append(sources, report.Changes[i].PackageName)
+	}
+
+	slices.Sort(sources)
+
+	via := make(map[string]ImportEdge)
+	visited := make(map[string]struct{})
+	queue := make([]string, 0, len(sources))
+
+	for _, src := range sources {
+		visited[src] = struct{}{}
+		queue = append(queue, src)
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range edges[current] {
+			if _, ok := visited[edge.Importer]; ok {
+				continue
+			}
+
+			visited[edge.Importer] = struct{}{}
+			via[edge.Importer] = edge
+
+			if edge.Importer == target {
+				return chainTo(via, target), true
+			}
+
+			queue = append(queue, edge.Importer)
+		}
+	}
+
+	return nil, false
+}
+
+// chainTo rebuilds the chain of edges leading to the given package from the BFS predecessors.
+func chainTo(via map[string]ImportEdge, pkg string) []ImportEdge {
+	chain := make([]ImportEdge, 0)
+
+	for {
+		edge, ok := via[pkg]
+		if !ok {
+			break
+		}
+
+		chain = append(chain, edge)
+		pkg = edge.Imported
+	}
+
+	slices.Reverse(chain)
+
+	return chain
+}
+
+// AllChains returns every chain of imports leading from a directly changed package (see
+// Report.Changes) to the target package, shortest first, up to limit chains (zero meaning
+// no limit). An empty chain is included when the target itself is directly changed. Test
+// imports are only followed when withTests is set.
+func AllChains(report *Report, target string, withTests bool, limit int) [][]ImportEdge {
+	chains := make([][]ImportEdge, 0)
+	sources := make([]string, 0, len(report.Changes))
+
+	for i := range report.Changes {
+		if matchPackagePattern(report.GoMod.Module.Path, report.Changes[i].PackageName, target) {
+			chains = append(chains, []ImportEdge{})
+		} else {
+			sources = append(sources, report.Changes[i].PackageName)
+		}
+	}
+
+	slices.Sort(sources)
+
+	edges := dependentEdges(report.AllPackages, withTests)
+	reaching := packagesReaching(edges, target)
+
+	// Partial chains are extended breadth-first, so complete ones are found shortest first.
+	type partial struct {
+		last  string
+		chain []ImportEdge
+	}
+
+	queue := make([]partial, 0, len(sources))
+
+	for _, src := range sources {
+		if _, ok := reaching[src]; ok {
+			queue = append(queue, partial{last: src, chain: []ImportEdge{}})
+		}
+	}
+
+	for len(queue) > 0 && (limit <= 0 || len(chains) < limit) {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range edges[current.last] {
+			if _, ok := reaching[edge.Importer]; !ok || edge.Importer == current.last || onChain(current.chain, edge.Importer) {
+				continue
+			}
+
+			chain := append(slices.Clone(current.chain), edge)
+
+			if edge.Importer == target {
+				chains = append(chains, chain)
+
+				if limit > 0 && len(chains) >= limit {
+					break
+				}
+
+				continue
+			}
+
+			queue = append(queue, partial{last: edge.Importer, chain: chain})
+		}
+	}
+
+	return chains
+}
+
+// onChain reports whether the given package is part of the chain of import edges.
+func onChain(chain []ImportEdge, pkg string) bool {
+	return slices.ContainsFunc(chain, func(edge ImportEdge) bool {
+		return edge.Imported == pkg || edge.Importer == pkg
+	})
+}
+
+// packagesReaching returns the packages from which the target can be reached following
+// the given dependent edges, the target included.
+func packagesReaching(edges map[string][]ImportEdge, target string) map[string]struct{} {
+	imports := make(map[string][]string)
+
+	for imported := range edges {
+		for _, edge := range edges[imported] {
+			imports[edge.Importer] = append(imports[edge.Importer], imported)
+		}
+	}
+
+	reaching := map[string]struct{}{target: {}}
+	queue := []string{target}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, imported := range imports[current] {
+			if _, ok := reaching[imported]; !ok {
+				reaching[imported] = struct{}{}
+				queue = append(queue, imported)
+			}
+		}
+	}
+
+	return reaching
+}
+
+// DependentEdges maps the import paths of the report packages, and of the packages they import,
+// to the edges towards the packages importing them, test imports included.
+func (r *Report) DependentEdges() map[string][]ImportEdge {
+	return dependentEdges(r.AllPackages, true)
+}
+
+// PackagesReaching returns the packages from which the target can be reached following
+// imports, test imports included, the target included.
+func (r *Report) PackagesReaching(target string) map[string]struct{} {
+	return packagesReaching(dependentEdges(r.AllPackages, true), target)
+}
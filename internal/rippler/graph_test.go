@@ -0,0 +1,132 @@
+package rippler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+)
+
+// newDiamondReport returns a report where pkg/strutil changed, and reaches cmd/api both
+// through internal/db and internal/cache, and cmd/worker through a test import only.
+func newDiamondReport() *Report {
+	worker := testPackage("cmd/worker")
+	worker.TestImports = []string{testImportPath("pkg/strutil")}
+
+	return newTestReport(
+		[]model.Package{
+			testPackage("pkg/strutil"),
+			testPackage("internal/db", "pkg/strutil"),
+			testPackage("internal/cache", "pkg/strutil"),
+			testPackage("cmd/api", "internal/db", "internal/cache"),
+			worker,
+		},
+		fileChange("pkg/strutil/strutil.go"),
+	)
+}
+
+// edge returns the import edge from one package to another, both relative to the test module.
+func edge(imported, importer string, kind ImportKind) ImportEdge {
+	return ImportEdge{Imported: testImportPath(imported), Importer: testImportPath(importer), Kind: kind}
+}
+
+func TestShortestChain(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		withTests bool
+		want      []ImportEdge
+		wantOK    bool
+	}{
+		{
+			name:   "changed package",
+			target: "pkg/strutil",
+			want:   []ImportEdge{},
+			wantOK: true,
+		},
+		{
+			name:   "dependent package",
+			target: "cmd/api",
+			want: []ImportEdge{
+				edge("pkg/strutil", "internal/db", ImportRegular),
+				edge("internal/db", "cmd/api", ImportRegular),
+			},
+			wantOK: true,
+		},
+		{
+			name:   "test dependent without tests",
+			target: "cmd/worker",
+		},
+		{
+			name:      "test dependent",
+			target:    "cmd/worker",
+			withTests: true,
+			want:      []ImportEdge{edge("pkg/strutil", "cmd/worker", ImportTest)},
+			wantOK:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ShortestChain(newDiamondReport(), testImportPath(tt.target), tt.withTests)
+			if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ShortestChain() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAllChains(t *testing.T) {
+	viaDB := []ImportEdge{
+		edge("pkg/strutil", "internal/db", ImportRegular),
+		edge("internal/db", "cmd/api", ImportRegular),
+	}
+	viaCache := []ImportEdge{
+		edge("pkg/strutil", "internal/cache", ImportRegular),
+		edge("internal/cache", "cmd/api", ImportRegular),
+	}
+
+	tests := []struct {
+		name      string
+		target    string
+		withTests bool
+		limit     int
+		want      [][]ImportEdge
+	}{
+		{
+			name:   "changed package",
+			target: "pkg/strutil",
+			want:   [][]ImportEdge{{}},
+		},
+		{
+			name:   "every chain",
+			target: "cmd/api",
+			want:   [][]ImportEdge{viaDB, viaCache},
+		},
+		{
+			name:   "limited",
+			target: "cmd/api",
+			limit:  1,
+			want:   [][]ImportEdge{viaDB},
+		},
+		{
+			name:   "test dependent without tests",
+			target: "cmd/worker",
+			want:   [][]ImportEdge{},
+		},
+		{
+			name:      "test dependent",
+			target:    "cmd/worker",
+			withTests: true,
+			want:      [][]ImportEdge{{edge("pkg/strutil", "cmd/worker", ImportTest)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AllChains(newDiamondReport(), testImportPath(tt.target), tt.withTests, tt.limit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AllChains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package rippler
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/glob"
+)
+
+// generatedRule is the rule reported for generated files dropped because their
+// generator input did not change.
+const generatedRule = "generated code"
+
+// generatedHeader matches the standard header of generated Go files,
+// see https://pkg.go.dev/cmd/go#hdr-Generate_Go_files_by_processing_source.
+var generatedHeader = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// IgnoredFile represents a changed file that is not considered as a package change.
+type IgnoredFile struct {
+	// Path is the absolute path of the ignored file.
+	Path string
+
+	// Rule is the ignore pattern, or the "generated code" rule, that dropped the file.
+	Rule string
+}
+
+// filterIgnoredFiles splits the given changed files into the ones to consider and the
+// ones dropped by ignore patterns or, when enabled, by the generated code rule.
+//
+// A generated file is only dropped when its generator input did not change. As generator
+// inputs (.proto files, go:generate directives, templates...) conventionally live next to
+// their outputs, this is approximated as "no other non-generated file changed in the same
+// directory".
+func (r *Rippler) filterIgnoredFiles(files []string) ([]string, []IgnoredFile) {
+	kept := make([]string, 0, len(files))
+	ignored := make([]IgnoredFile, 0)
+
+	for i := range files {
+		if pattern, ok := glob.MatchAny(r.ignorePatterns, r.relativeToRepository(files[i])); ok {
+			ignored = append(ignored, IgnoredFile{Path: files[i], Rule: pattern})
+
+			continue
+		}
+
+		kept = append(kept, files[i])
+	}
+
+	if !r.ignoreGenerated {
+		return kept, ignored
+	}
+
+	generated := make(map[string]bool)
+	inputChanged := make(map[string]bool)
+
+	for i := range kept {
+		generated[kept[i]] = isGeneratedFile(kept[i])
+
+		if !generated[kept[i]] {
+			inputChanged[filepath.Dir(kept[i])] = true
+		}
+	}
+
+	out := make([]string, 0, len(kept))
+
+	for i := range kept {
+		if generated[kept[i]] && !inputChanged[filepath.Dir(kept[i])] {
+			ignored = append(ignored, IgnoredFile{Path: kept[i], Rule: generatedRule})
+
+			continue
+		}
+
+		out = append(out, kept[i])
+	}
+
+	return out, ignored
+}
+
+// isGeneratedFile reports whether the given file is a Go file carrying the standard
+// "Code generated ... DO NOT EDIT." header. Files that cannot be read, such as deleted
+// ones, are not considered generated.
+func isGeneratedFile(path string) bool {
+	if !strings.HasSuffix(path, ".go") {
+		return false
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if generatedHeader.MatchString(line) {
+			return true
+		}
+
+		// The header must appear before the first non-comment, non-blank text.
+		if line != "" && !strings.HasPrefix(line, "//") {
+			return false
+		}
+	}
+
+	return false
+}
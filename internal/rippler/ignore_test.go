@@ -0,0 +1,133 @@
+package rippler
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFilterIgnoredFiles(t *testing.T) {
+	repo := t.TempDir()
+
+	files := map[string]string{
+		"docs/guide.md":         "# Guide\n",
+		"users/user.go":         "package users\n",
+		"users/user_mock.go":    "// Code generated by MockGen. DO NOT EDIT.\n\npackage users\n",
+		"api/api.pb.go":         "// Copyright 2026.\n\n// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage api\n",
+		"api/api.proto":         "syntax = \"proto3\";\n",
+		"billing/billing.pb.go": "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage billing\n",
+		"orders/order.go":       "package orders\n\n// Code generated by hand. DO NOT EDIT.\n",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(repo, name)
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	changed := func(names ...string) []string {
+		out := make([]string, 0, len(names))
+		for _, name := range names {
+			out = append(out, filepath.Join(repo, name))
+		}
+
+		return out
+	}
+
+	all := changed(
+		"docs/guide.md",
+		"users/user.go",
+		"users/user_mock.go",
+		"api/api.pb.go",
+		"api/api.proto",
+		"billing/billing.pb.go",
+		"orders/order.go",
+		"deleted/gone.go",
+	)
+
+	tests := []struct {
+		name        string
+		patterns    []string
+		generated   bool
+		wantKept    []string
+		wantIgnored []IgnoredFile
+	}{
+		{
+			name:        "nothing ignored",
+			wantKept:    all,
+			wantIgnored: []IgnoredFile{},
+		},
+		{
+			name:     "ignore patterns",
+			patterns: []string{"docs/**", "**/*.proto"},
+			wantKept: changed(
+				"users/user.go",
+				"users/user_mock.go",
+				"api/api.pb.go",
+				"billing/billing.pb.go",
+				"orders/order.go",
+				"deleted/gone.go",
+			),
+			wantIgnored: []IgnoredFile{
+				{Path: filepath.Join(repo, "docs/guide.md"), Rule: "docs/**"},
+				{Path: filepath.Join(repo, "api/api.proto"), Rule: "**/*.proto"},
+			},
+		},
+		{
+			name:      "generated files without changed inputs",
+			generated: true,
+			wantKept: changed(
+				"docs/guide.md",
+				"users/user.go",
+				"users/user_mock.go",
+				"api/api.pb.go",
+				"api/api.proto",
+				"orders/order.go",
+				"deleted/gone.go",
+			),
+			wantIgnored: []IgnoredFile{
+				{Path: filepath.Join(repo, "billing/billing.pb.go"), Rule: generatedRule},
+			},
+		},
+		{
+			name:      "generator input ignored",
+			patterns:  []string{"**/*.proto"},
+			generated: true,
+			wantKept: changed(
+				"docs/guide.md",
+				"users/user.go",
+				"users/user_mock.go",
+				"orders/order.go",
+				"deleted/gone.go",
+			),
+			wantIgnored: []IgnoredFile{
+				{Path: filepath.Join(repo, "api/api.proto"), Rule: "**/*.proto"},
+				{Path: filepath.Join(repo, "api/api.pb.go"), Rule: generatedRule},
+				{Path: filepath.Join(repo, "billing/billing.pb.go"), Rule: generatedRule},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Rippler{repoRoot: repo, ignorePatterns: tt.patterns, ignoreGenerated: tt.generated}
+
+			kept, ignored := r.filterIgnoredFiles(all)
+
+			if !reflect.DeepEqual(kept, tt.wantKept) {
+				t.Errorf("filterIgnoredFiles() kept = %v, want %v", kept, tt.wantKept)
+			}
+
+			if !reflect.DeepEqual(ignored, tt.wantIgnored) {
+				t.Errorf("filterIgnoredFiles() ignored = %v, want %v", ignored, tt.wantIgnored)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package rippler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+)
+
+// Impact computes the blast radius of hypothetical changes: instead of diffing against a
+// base revision, the given packages and files are considered changed, and the packages
+// depending on them are propagated as usual. Targets are either paths of existing files,
+// or package patterns (import paths, or paths relative to the module root, optionally
+// ending with "/...").
+func (r *Rippler) Impact(ctx context.Context, targets []string) (*Report, error) {
+	report := &Report{}
+
+	mod, err := r.parseGoMod(ctx, r.goModFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+
+	report.GoMod = mod
+	report.ModuleDir = filepath.Dir(r.goModFilePath)
+
+	allPackages, err := r.listAllPackages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all packages: %w", err)
+	}
+
+	report.AllPackages = allPackages
+
+	if pErr := r.checkPackagePatterns(report); pErr != nil {
+		return nil, fmt.Errorf("invalid package patterns: %w", pErr)
+	}
+
+	// Outside a Git repository, rules and reported paths are relative to the module root.
+	repoRoot, err := RepositoryRoot(ctx, report.ModuleDir)
+	if err != nil {
+		repoRoot = report.ModuleDir
+	}
+
+	r.repoRoot = repoRoot
+	report.RepositoryDir = repoRoot
+
+	changes := make([]Change, 0)
+
+	for _, target := range targets {
+		if info, stErr := os.Stat(target); stErr == nil && !info.IsDir() {
+			file, absErr := filepath.Abs(target)
+			if absErr != nil {
+				return nil, fmt.Errorf("failed to get absolute path of %s: %w", target, absErr)
+			}
+
+			report.ChangedFiles = append(report.ChangedFiles, file)
+
+			continue
+		}
+
+		pkgs, rErr := r.impactedPackages(report, target)
+		if rErr != nil {
+			return nil, rErr
+		}
+
+		for _, pkg := range pkgs {
+			changes = append(changes, Change{
+				PackageName: pkg,
+				Reasons:     []Reason{{Kind: ReasonRequested}},
+			})
+		}
+	}
+
+	report.DirtyFiles = goFiles(report.ChangedFiles)
+
+	fileChanges := r.affectedPackagesByFileChanges(report)
+	fileChanges = append(fileChanges, r.affectedPackagesByRules(report)...)
+
+	for _, file := range report.ChangedFiles {
+		if !slices.ContainsFunc(fileChanges, func(ch Change) bool {
+			return slices.ContainsFunc(ch.Reasons, func(reason Reason) bool { return reason.File == file })
+		}) {
+			return nil, fmt.Errorf("file %s does not belong to any package nor match any rule", file)
+		}
+	}
+
+	report.Changes = unifyChanges(append(changes, fileChanges...))
+	report.AffectedPackages = r.applyPackageFilters(report, r.propagateAffectedPackages(report))
+	report.Applications = r.affectedApplications(report)
+	report.Binaries = r.affectedBinaries(report)
+	report.Artifacts = r.affectedArtifacts(report)
+
+	return report, nil
+}
+
+// impactedPackages resolves a package pattern given to Impact into the import paths of the
+// packages it designates. Packages outside the project are accepted as long as some project
+// package depends on them.
+func (r *Rippler) impactedPackages(report *Report, pattern string) ([]string, error) {
+	if !strings.HasSuffix(pattern, "/...") {
+		pattern = report.ResolvePackage(pattern)
+	}
+
+	resolved := resolvePackagePattern(report.GoMod.Module.Path, pattern)
+	out := make([]string, 0)
+
+	for i := range report.AllPackages {
+		pkg := report.AllPackages[i]
+
+		if matchPackagePattern(report.GoMod.Module.Path, resolved, pkg.ImportPath) {
+			out = append(out, pkg.ImportPath)
+		}
+	}
+
+	if len(out) == 0 && slices.ContainsFunc(report.AllPackages, func(pkg model.Package) bool {
+		return slices.Contains(pkg.Deps, resolved) || slices.Contains(pkg.TestImports, resolved) || slices.Contains(pkg.XTestImports, resolved)
+	}) {
+		out = append(out, resolved)
+	}
+
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no package matches %s", pattern)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,51 @@
+package rippler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+)
+
+func TestImpactedPackages(t *testing.T) {
+	api := testPackage("cmd/api", "internal/db")
+	api.Deps = []string{testImportPath("internal/db"), "github.com/lib/pq"}
+
+	worker := testPackage("cmd/worker")
+	worker.XTestImports = []string{"github.com/stretchr/testify/assert"}
+
+	report := newTestReport([]model.Package{testPackage("internal/db"), testPackage("internal/cache"), api, worker})
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+		wantErr bool
+	}{
+		{name: "import path", pattern: testImportPath("internal/db"), want: []string{testImportPath("internal/db")}},
+		{name: "relative to the module", pattern: "./internal/db", want: []string{testImportPath("internal/db")}},
+		{name: "module directory", pattern: "internal/db", want: []string{testImportPath("internal/db")}},
+		{
+			name:    "wildcard",
+			pattern: "./internal/...",
+			want:    []string{testImportPath("internal/db"), testImportPath("internal/cache")},
+		},
+		{name: "dependency", pattern: "github.com/lib/pq", want: []string{"github.com/lib/pq"}},
+		{name: "test dependency", pattern: "github.com/stretchr/testify/assert", want: []string{"github.com/stretchr/testify/assert"}},
+		{name: "unknown package", pattern: "./internal/dbs", wantErr: true},
+		{name: "unused dependency", pattern: "github.com/x/y", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Rippler{}).impactedPackages(report, tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("impactedPackages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("impactedPackages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,4 +1,155 @@
 package rippler
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/glob"
+)
+
 // Option is a function that configures a Rippler.
 type Option func(*Rippler) error
+
+// WithFileRules sets the rules mapping changed files to the packages they affect.
+func WithFileRules(rules ...FileRule) Option {
+	return func(r *Rippler) error {
+		for i := range rules {
+			if len(rules[i].Files) == 0 || len(rules[i].Packages) == 0 {
+				return fmt.Errorf("rule %q must declare both files and packages", rules[i].String())
+			}
+		}
+
+		r.rules = append(r.rules, rules...)
+
+		return nil
+	}
+}
+
+// WithIgnorePatterns sets path patterns of changed files that must not be considered as
+// package changes, e.g. "docs/" or "*.md". Patterns are relative to the repository root.
+func WithIgnorePatterns(patterns ...string) Option {
+	return func(r *Rippler) error {
+		for i := range patterns {
+			if err := glob.Validate(patterns[i]); err != nil {
+				return fmt.Errorf("invalid ignore pattern: %w", err)
+			}
+		}
+
+		r.ignorePatterns = append(r.ignorePatterns, patterns...)
+
+		return nil
+	}
+}
+
+// WithIgnoreGeneratedFiles makes changes to generated Go files non-triggering, as long as
+// no other non-generated file (i.e. their generator input) changed in the same directory.
+func WithIgnoreGeneratedFiles() Option {
+	return func(r *Rippler) error {
+		r.ignoreGenerated = true
+
+		return nil
+	}
+}
+
+// WithGlobalTriggers sets path patterns that, when matched by any changed file, cause every
+// package of the project to be considered affected, e.g. "Makefile" or ".github/workflows/**".
+// Patterns are relative to the repository root.
+func WithGlobalTriggers(patterns ...string) Option {
+	return func(r *Rippler) error {
+		for i := range patterns {
+			if err := glob.Validate(patterns[i]); err != nil {
+				return fmt.Errorf("invalid global trigger: %w", err)
+			}
+		}
+
+		r.triggers = append(r.triggers, patterns...)
+
+		return nil
+	}
+}
+
+// WithIncludedPackages sets package patterns that are always reported as affected.
+func WithIncludedPackages(patterns ...string) Option {
+	return func(r *Rippler) error {
+		for i := range patterns {
+			if strings.TrimSpace(patterns[i]) == "" {
+				return fmt.Errorf("empty package pattern in included packages")
+			}
+		}
+
+		r.included = append(r.included, patterns...)
+
+		return nil
+	}
+}
+
+// WithExcludedPackages sets package patterns that are never reported as affected.
+// Excluded packages still propagate changes to the packages importing them.
+func WithExcludedPackages(patterns ...string) Option {
+	return func(r *Rippler) error {
+		for i := range patterns {
+			if strings.TrimSpace(patterns[i]) == "" {
+				return fmt.Errorf("empty package pattern in excluded packages")
+			}
+		}
+
+		r.excluded = append(r.excluded, patterns...)
+
+		return nil
+	}
+}
+
+// WithApplications sets the applications of the project, used to group affected packages.
+// When none is given, every main package living under a "cmd" directory is an application.
+func WithApplications(apps ...ApplicationRoot) Option {
+	return func(r *Rippler) error {
+		for i := range apps {
+			if len(apps[i].Packages) == 0 {
+				return fmt.Errorf("application %q must declare at least one package", apps[i].Name)
+			}
+		}
+
+		r.applications = append(r.applications, apps...)
+
+		return nil
+	}
+}
+
+// WithArtifacts sets the deployable artifacts of the project, reported when affected.
+func WithArtifacts(artifacts ...ArtifactRule) Option {
+	return func(r *Rippler) error {
+		for i := range artifacts {
+			if artifacts[i].Name == "" {
+				return fmt.Errorf("artifacts must have a name")
+			}
+
+			if len(artifacts[i].Packages) == 0 && len(artifacts[i].Files) == 0 {
+				return fmt.Errorf("artifact %q must declare packages or files", artifacts[i].Name)
+			}
+
+			for _, pattern := range artifacts[i].Files {
+				if err := glob.Validate(pattern); err != nil {
+					return fmt.Errorf("invalid file pattern for artifact %q: %w", artifacts[i].Name, err)
+				}
+			}
+		}
+
+		r.artifacts = append(r.artifacts, artifacts...)
+
+		return nil
+	}
+}
+
+// WithMaxDepth limits the propagation to packages at most depth imports away from a direct
+// change, for quick local checks. Zero means no limit.
+func WithMaxDepth(depth int) Option {
+	return func(r *Rippler) error {
+		if depth < 0 {
+			return fmt.Errorf("max depth must not be negative, got %d", depth)
+		}
+
+		r.maxDepth = depth
+
+		return nil
+	}
+}
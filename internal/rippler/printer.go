@@ -1,15 +1,19 @@
 package rippler
 
+import (
+	"io"
+)
+
 // ReportPrinter is an interface for printing reports generated by the rippler tool.
 type ReportPrinter interface {
-	// Print outputs the report in a specific format.
-	Print(report *Report) error
+	// Print writes the report to w in a specific format.
+	Print(w io.Writer, report *Report) error
 }
 
 // ReportPrinterFunc is a function type that implements the ReportPrinter interface.
-type ReportPrinterFunc func(report *Report) error
+type ReportPrinterFunc func(w io.Writer, report *Report) error
 
 // Print calls the ReportPrinterFunc with the provided report.
-func (f ReportPrinterFunc) Print(report *Report) error {
-	return f(report)
+func (f ReportPrinterFunc) Print(w io.Writer, report *Report) error {
+	return f(w, report)
 }
@@ -0,0 +1,49 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+type artifactsPrinter struct{}
+
+type artifact struct {
+	Name  string   `json:"name"`
+	Kind  string   `json:"kind,omitempty"`
+	Chain []string `json:"chain,omitempty"`
+	Files []string `json:"files,omitempty"`
+}
+
+// NewArtifactsPrinter creates a new instance of the artifacts printer, which lists the affected
+// deployable artifacts along with the package chain that pulled each one in.
+func NewArtifactsPrinter() rippler.ReportPrinter {
+	return &artifactsPrinter{}
+}
+
+// Print prints the affected artifacts in JSON format.
+func (a *artifactsPrinter) Print(w io.Writer, report *rippler.Report) error {
+	artifacts := make([]artifact, 0, len(report.Artifacts))
+
+	for i := range report.Artifacts {
+		artifacts = append(artifacts, artifact{
+			Name:  report.Artifacts[i].Name,
+			Kind:  report.Artifacts[i].Kind,
+			Chain: report.Artifacts[i].Chain,
+			Files: relativePaths(report, report.Artifacts[i].Files),
+		})
+	}
+
+	jsonData, err := json.MarshalIndent(artifacts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(w, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to write artifacts: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package printers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestArtifactsPrinter(t *testing.T) {
+	report := &rippler.Report{
+		RepositoryDir: "/repo",
+		Artifacts: []rippler.Artifact{
+			{Name: "api", Kind: "service", Chain: []string{"example.com/project/db", "example.com/project/cmd/api"}},
+			{Name: "worker", Files: []string{"/repo/deploy/worker/values.yaml", "/repo/Makefile"}},
+		},
+	}
+
+	want := `[
+  {
+    "name": "api",
+    "kind": "service",
+    "chain": [
+      "example.com/project/db",
+      "example.com/project/cmd/api"
+    ]
+  },
+  {
+    "name": "worker",
+    "files": [
+      "Makefile",
+      "deploy/worker/values.yaml"
+    ]
+  }
+]
+`
+
+	var out bytes.Buffer
+	if err := NewArtifactsPrinter().Print(&out, report); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+
+	if got := out.String(); got != want {
+		t.Errorf("Print() =\n%s\nwant\n%s", got, want)
+	}
+}
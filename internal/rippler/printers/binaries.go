@@ -0,0 +1,54 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+type binariesPrinter struct{}
+
+type binary struct {
+	Name       string         `json:"name"`
+	ImportPath string         `json:"importPath"`
+	Dir        string         `json:"dir"`
+	Reasons    []reportReason `json:"reasons"`
+}
+
+// NewBinariesPrinter creates a new instance of the binaries printer, which lists the affected
+// main packages, i.e. the executables that must be rebuilt.
+func NewBinariesPrinter() rippler.ReportPrinter {
+	return &binariesPrinter{}
+}
+
+// Print prints the affected binaries in JSON format.
+func (b *binariesPrinter) Print(w io.Writer, report *rippler.Report) error {
+	binaries := make([]binary, 0, len(report.Binaries))
+
+	for i := range report.Binaries {
+		binaries = append(binaries, newBinary(report, report.Binaries[i]))
+	}
+
+	jsonData, err := json.MarshalIndent(binaries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(w, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to write binaries: %w", err)
+	}
+
+	return nil
+}
+
+// newBinary converts an affected binary, describing each of its reasons.
+func newBinary(report *rippler.Report, bin rippler.Binary) binary {
+	reasons := make([]reportReason, 0, len(bin.Reasons))
+	for _, reason := range bin.Reasons {
+		reasons = append(reasons, newReportReason(report, reason))
+	}
+
+	return binary{Name: bin.Name, ImportPath: bin.ImportPath, Dir: bin.Dir, Reasons: reasons}
+}
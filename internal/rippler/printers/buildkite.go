@@ -0,0 +1,175 @@
+package printers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"text/template"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultBuildkiteTestCommand is the test command template used when none is given: it tests
+// the packages of the group from the module directory, steps starting at the repository root.
+const DefaultBuildkiteTestCommand = `{{ if .Context }}cd {{ .Context }} && {{ end }}go test {{ join " " .Packages }}`
+
+// buildkiteKeyUnsafe matches the characters not allowed in Buildkite step keys.
+var buildkiteKeyUnsafe = regexp.MustCompile(`[^a-zA-Z0-9_:-]+`)
+
+// BuildkiteSteps configures the steps of the buildkite printer. Commands are text/templates
+// rendered with the same data and helper functions as the gitlab-pipeline job templates.
+type BuildkiteSteps struct {
+	// BuildCommand is the command template of the build step of each group. No build step is
+	// generated when empty.
+	BuildCommand string
+
+	// BuildQueue is the agents queue of the build steps, the default one when empty.
+	BuildQueue string
+
+	// TestCommand is the command template of the test step of each group, depending on its
+	// build step. Defaults to DefaultBuildkiteTestCommand.
+	TestCommand string
+
+	// TestQueue is the agents queue of the test steps, the default one when empty.
+	TestQueue string
+}
+
+type buildkitePrinter struct {
+	grouping Grouping
+	steps    BuildkiteSteps
+}
+
+type buildkitePipeline struct {
+	Steps []buildkiteStep `yaml:"steps"`
+}
+
+type buildkiteStep struct {
+	Label     string            `yaml:"label"`
+	Key       string            `yaml:"key,omitempty"`
+	Command   string            `yaml:"command"`
+	Agents    map[string]string `yaml:"agents,omitempty"`
+	DependsOn string            `yaml:"depends_on,omitempty"`
+}
+
+// NewBuildkitePrinter creates a new instance of the Buildkite printer, which writes pipeline
+// steps, suitable for `buildkite-agent pipeline upload`, building and testing each group of
+// affected packages.
+func NewBuildkitePrinter(grouping Grouping, steps BuildkiteSteps) (rippler.ReportPrinter, error) {
+	if steps.TestCommand == "" {
+		steps.TestCommand = DefaultBuildkiteTestCommand
+	}
+
+	for _, command := range []string{steps.BuildCommand, steps.TestCommand} {
+		if _, err := template.New("command").Funcs(templateFuncs(&rippler.Report{})).Parse(command); err != nil {
+			return nil, fmt.Errorf("failed to parse command template: %w", err)
+		}
+	}
+
+	return &buildkitePrinter{grouping: grouping, steps: steps}, nil
+}
+
+// Print prints the pipeline steps in YAML format.
+func (b *buildkitePrinter) Print(w io.Writer, report *rippler.Report) error {
+	groups, err := b.grouping.groups(report)
+	if err != nil {
+		return err
+	}
+
+	pipeline := buildkitePipeline{Steps: make([]buildkiteStep, 0)}
+
+	if len(groups) == 0 {
+		pipeline.Steps = append(pipeline.Steps, buildkiteStep{
+			Label:   "No affected packages",
+			Command: `echo "No affected packages, nothing to test."`,
+		})
+	}
+
+	keys := make(map[string]struct{})
+
+	for _, group := range groups {
+		data := newJobData(report, group)
+		key := buildkiteKey(group.Name, keys)
+		dependsOn := ""
+
+		if b.steps.BuildCommand != "" {
+			command, rErr := renderCommand(report, b.steps.BuildCommand, data)
+			if rErr != nil {
+				return fmt.Errorf("failed to render build command for %s: %w", group.Name, rErr)
+			}
+
+			pipeline.Steps = append(pipeline.Steps, buildkiteStep{
+				Label:   "build " + group.Name,
+				Key:     "build:" + key,
+				Command: command,
+				Agents:  buildkiteAgents(b.steps.BuildQueue),
+			})
+
+			dependsOn = "build:" + key
+		}
+
+		command, rErr := renderCommand(report, b.steps.TestCommand, data)
+		if rErr != nil {
+			return fmt.Errorf("failed to render test command for %s: %w", group.Name, rErr)
+		}
+
+		pipeline.Steps = append(pipeline.Steps, buildkiteStep{
+			Label:     "test " + group.Name,
+			Key:       "test:" + key,
+			Command:   command,
+			Agents:    buildkiteAgents(b.steps.TestQueue),
+			DependsOn: dependsOn,
+		})
+	}
+
+	node := &yaml.Node{}
+	if eErr := node.Encode(pipeline); eErr != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", eErr)
+	}
+
+	return writeYAML(w, node)
+}
+
+// renderCommand renders a command template for a group of packages.
+func renderCommand(report *rippler.Report, text string, data jobData) (string, error) {
+	tpl, err := template.New("command").Funcs(templateFuncs(report)).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse command template: %w", err)
+	}
+
+	out := bytes.Buffer{}
+	if eErr := tpl.Execute(&out, data); eErr != nil {
+		return "", eErr
+	}
+
+	return out.String(), nil
+}
+
+// buildkiteKey returns the step key suffix of a group, its name with the characters Buildkite
+// rejects replaced. Names colliding once sanitized, e.g. "a/b" and "a.b", get a numbered
+// suffix, as step keys must be unique within a pipeline.
+func buildkiteKey(name string, used map[string]struct{}) string {
+	base := buildkiteKeyUnsafe.ReplaceAllString(name, "-")
+	key := base
+
+	for i := 2; ; i++ {
+		if _, ok := used[key]; !ok {
+			break
+		}
+
+		key = fmt.Sprintf("%s-%d", base, i)
+	}
+
+	used[key] = struct{}{}
+
+	return key
+}
+
+func buildkiteAgents(queue string) map[string]string {
+	if queue == "" {
+		return nil
+	}
+
+	return map[string]string{"queue": queue}
+}
@@ -0,0 +1,67 @@
+package printers
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBuildkiteKey(t *testing.T) {
+	used := make(map[string]struct{})
+
+	for _, tt := range []struct {
+		name string
+		want string
+	}{
+		{name: "shard-1", want: "shard-1"},
+		{name: "example.com/project", want: "example-com-project"},
+		{name: "example.com/project/tools", want: "example-com-project-tools"},
+		{name: "example-com/project", want: "example-com-project-2"},
+		{name: "example.com project", want: "example-com-project-3"},
+	} {
+		if got := buildkiteKey(tt.name, used); got != tt.want {
+			t.Errorf("buildkiteKey(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBuildkitePrinterByModule(t *testing.T) {
+	printer, err := NewBuildkitePrinter(Grouping{By: GroupByModule}, BuildkiteSteps{
+		BuildCommand: "make build DIRS='{{ join \" \" .Dirs }}'",
+		TestQueue:    "tests",
+	})
+	if err != nil {
+		t.Fatalf("NewBuildkitePrinter() error = %v", err)
+	}
+
+	report := newWorkspaceReport("example.com/project/users", "example.com/project/tools/lint")
+
+	want := `steps:
+  - label: build example.com/project
+    key: build:example-com-project
+    command: make build DIRS='./users'
+  - label: test example.com/project
+    key: test:example-com-project
+    command: go test example.com/project/users
+    agents:
+      queue: tests
+    depends_on: build:example-com-project
+  - label: build example.com/project/tools
+    key: build:example-com-project-tools
+    command: make build DIRS='./lint'
+  - label: test example.com/project/tools
+    key: test:example-com-project-tools
+    command: cd tools && go test example.com/project/tools/lint
+    agents:
+      queue: tests
+    depends_on: build:example-com-project-tools
+`
+
+	var out bytes.Buffer
+	if pErr := printer.Print(&out, report); pErr != nil {
+		t.Fatalf("Print() error = %v", pErr)
+	}
+
+	if got := out.String(); got != want {
+		t.Errorf("Print() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+package printers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+// GraphCollapse selects how packages are merged into coarser nodes when rendering graphs,
+// so large graphs stay readable.
+type GraphCollapse string
+
+const (
+	// CollapseNone renders every package as its own node.
+	CollapseNone GraphCollapse = "none"
+
+	// CollapseDirectories merges the packages of the project by top-level directory.
+	CollapseDirectories GraphCollapse = "dir"
+
+	// CollapseModules merges packages by module, the whole project becoming a single node.
+	CollapseModules GraphCollapse = "module"
+)
+
+type dotPrinter struct {
+	collapse GraphCollapse
+}
+
+// NewDOTPrinter creates a new instance of the Graphviz DOT printer, which renders the subgraph
+// of the directly changed packages, their transitive dependents and the imports between them.
+func NewDOTPrinter(collapse GraphCollapse) rippler.ReportPrinter {
+	return &dotPrinter{collapse: collapse}
+}
+
+// Print prints the affected subgraph in Graphviz DOT format. Edges point from changed
+// packages to the packages importing them, dashed when the import only comes from tests.
+func (d *dotPrinter) Print(w io.Writer, report *rippler.Report) error {
+	nodes, edges := collapsedGraph(report, d.collapse)
+
+	b := strings.Builder{}
+	b.WriteString("digraph ripple {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=\"rounded\", fontname=\"Helvetica\"];\n")
+
+	for _, node := range nodes {
+		label := node.Label
+		if node.Size > 1 {
+			label = fmt.Sprintf("%s\n(%d packages)", label, node.Size)
+		}
+
+		fmt.Fprintf(&b, "  %q [label=%q%s];\n", node.ID, label, dotNodeStyle(node.Kind))
+	}
+
+	for _, edge := range edges {
+		style := ""
+		if edge.Test {
+			style = " [style=dashed, label=\"test\"]"
+		}
+
+		fmt.Fprintf(&b, "  %q -> %q%s;\n", edge.From, edge.To, style)
+	}
+
+	b.WriteString("}")
+
+	if _, err := fmt.Fprintln(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write DOT graph: %w", err)
+	}
+
+	return nil
+}
+
+func dotNodeStyle(kind nodeKind) string {
+	switch kind {
+	case nodeDirect:
+		return `, style="rounded,filled,bold", fillcolor="palegreen"`
+	case nodeExternal:
+		return `, shape=ellipse, style="dashed", color="gray40"`
+	default:
+		return ""
+	}
+}
+
+// collapsedGraph returns the affected graph of the report, collapsed as requested.
+func collapsedGraph(report *rippler.Report, collapse GraphCollapse) ([]graphNode, []graphEdge) {
+	nodes, edges := affectedGraph(report)
+
+	switch collapse {
+	case CollapseDirectories:
+		return collapseGraph(nodes, edges, func(node graphNode) string {
+			return topLevelDir(report, node.ID)
+		})
+	case CollapseModules:
+		return collapseGraph(nodes, edges, func(node graphNode) string {
+			if node.Kind == nodeExternal {
+				return node.ID
+			}
+
+			return report.GoMod.Module.Path
+		})
+	default:
+		return nodes, edges
+	}
+}
@@ -0,0 +1,81 @@
+package printers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestDOTPrinter(t *testing.T) {
+	const module = "example.com/project"
+
+	report := &rippler.Report{
+		GoMod: model.GoMod{Module: model.GoModDependency{Path: module}},
+		AllPackages: []model.Package{
+			{ImportPath: module + "/internal/db", Imports: []string{"github.com/lib/pq"}},
+			{ImportPath: module + "/internal/cache"},
+			{ImportPath: module + "/api/http", Imports: []string{module + "/internal/db"}},
+			{ImportPath: module + "/api/grpc", TestImports: []string{module + "/internal/db"}},
+		},
+		Changes: []rippler.Change{{PackageName: "github.com/lib/pq"}},
+		AffectedPackages: []model.AffectedPackage{
+			{ImportPath: module + "/api/grpc"},
+			{ImportPath: module + "/api/http"},
+			{ImportPath: module + "/internal/db"},
+			{ImportPath: "github.com/lib/pq", Indirect: true},
+		},
+	}
+
+	const header = "digraph ripple {\n  rankdir=LR;\n  node [shape=box, style=\"rounded\", fontname=\"Helvetica\"];\n"
+
+	tests := []struct {
+		collapse GraphCollapse
+		want     string
+	}{
+		{
+			collapse: CollapseNone,
+			want: header +
+				`  "example.com/project/api/grpc" [label="api/grpc"];` + "\n" +
+				`  "example.com/project/api/http" [label="api/http"];` + "\n" +
+				`  "example.com/project/internal/db" [label="internal/db"];` + "\n" +
+				`  "github.com/lib/pq" [label="github.com/lib/pq", shape=ellipse, style="dashed", color="gray40"];` + "\n" +
+				`  "example.com/project/internal/db" -> "example.com/project/api/grpc" [style=dashed, label="test"];` + "\n" +
+				`  "example.com/project/internal/db" -> "example.com/project/api/http";` + "\n" +
+				`  "github.com/lib/pq" -> "example.com/project/internal/db";` + "\n" +
+				"}\n",
+		},
+		{
+			collapse: CollapseDirectories,
+			want: header +
+				`  "api" [label="api\n(2 packages)"];` + "\n" +
+				`  "github.com/lib/pq" [label="github.com/lib/pq", shape=ellipse, style="dashed", color="gray40"];` + "\n" +
+				`  "internal" [label="internal"];` + "\n" +
+				`  "github.com/lib/pq" -> "internal";` + "\n" +
+				`  "internal" -> "api";` + "\n" +
+				"}\n",
+		},
+		{
+			collapse: CollapseModules,
+			want: header +
+				`  "example.com/project" [label="example.com/project\n(3 packages)"];` + "\n" +
+				`  "github.com/lib/pq" [label="github.com/lib/pq", shape=ellipse, style="dashed", color="gray40"];` + "\n" +
+				`  "github.com/lib/pq" -> "example.com/project";` + "\n" +
+				"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.collapse), func(t *testing.T) {
+			var out bytes.Buffer
+			if err := NewDOTPrinter(tt.collapse).Print(&out, report); err != nil {
+				t.Fatalf("Print() error = %v", err)
+			}
+
+			if got := out.String(); got != tt.want {
+				t.Errorf("Print() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
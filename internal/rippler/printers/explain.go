@@ -2,56 +2,89 @@ package printers
 
 import (
 	"fmt"
+	"io"
+	"slices"
+	"strings"
 
 	"github.com/tangelo-labs/go-ripple/internal/rippler"
 )
 
-type explainPrinter struct{}
+type explainPrinter struct {
+	focus string
+	color bool
+}
 
 type treeNode struct {
 	PackageName string
-	Children    []*treeNode
+
+	// Kind is the kind of import pulling the package in from its parent, empty for roots.
+	Kind rippler.ImportKind
+
+	// Version describes the version change of external module roots, e.g. "v1.1.0 -> v1.2.0".
+	Version string
+
+	// Seen tells the package is already shown, with its dependents, above in the tree.
+	Seen bool
+
+	Children []*treeNode
 }
 
 // NewExplainPrinter creates a new instance of the explain printer for displaying ripple reports.
-func NewExplainPrinter() rippler.ReportPrinter {
-	return &explainPrinter{}
+// When focus is set, the dependency tree only shows the import paths leading to that package.
+// When color is set, direct changes are highlighted with ANSI escape codes, which is only
+// suitable for terminals.
+func NewExplainPrinter(focus string, color bool) rippler.ReportPrinter {
+	return &explainPrinter{focus: focus, color: color}
 }
 
-func (p *explainPrinter) Print(report *rippler.Report) error {
-	fmt.Println("Direct changes detected:")
-	p.changes(report)
+func (p *explainPrinter) Print(w io.Writer, report *rippler.Report) error {
+	b := strings.Builder{}
+
+	b.WriteString("Direct changes detected:\n")
+	p.changes(&b, report)
+
+	if len(report.IgnoredFiles) > 0 {
+		b.WriteString("\n\nIgnored files:\n")
+		p.ignored(&b, report)
+	}
 
-	println()
-	println()
+	b.WriteString("\n\nDependency tree of affected packages:\n")
+	p.tree(&b, report)
 
-	fmt.Println("Dependency tree of affected packages:")
-	p.tree(report)
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write explanation: %w", err)
+	}
 
 	return nil
 }
 
-func (p *explainPrinter) changes(report *rippler.Report) {
+func (p *explainPrinter) changes(w io.Writer, report *rippler.Report) {
 	if len(report.Changes) == 0 {
 		return
 	}
 
 	for i := range report.Changes {
-		fmt.Printf("- %s\n", report.Changes[i].PackageName)
+		fmt.Fprintf(w, "- %s\n", report.Changes[i].PackageName)
 
 		if len(report.Changes[i].Reasons) > 0 {
-			fmt.Println("  Reasons:")
+			fmt.Fprintln(w, "  Reasons:")
 
 			for _, reason := range report.Changes[i].Reasons {
-				fmt.Printf("  - %s\n", reason)
+				fmt.Fprintf(w, "  - %s\n", reason)
 			}
 		} else {
-			fmt.Println("  No specific reasons provided for this change.")
+			fmt.Fprintln(w, "  No specific reasons provided for this change.")
 		}
 	}
 }
 
-func (p *explainPrinter) tree(report *rippler.Report) {
+func (p *explainPrinter) ignored(w io.Writer, report *rippler.Report) {
+	for i := range report.IgnoredFiles {
+		fmt.Fprintf(w, "- %s (rule: %s)\n", report.IgnoredFiles[i].Path, report.IgnoredFiles[i].Rule)
+	}
+}
+
+func (p *explainPrinter) tree(w io.Writer, report *rippler.Report) {
 	roots := p.buildTree(report)
 
 	if len(roots) == 0 {
@@ -64,22 +97,34 @@ func (p *explainPrinter) tree(report *rippler.Report) {
 	}
 
 	for i, root := range roots {
-		p.printTreeNode(root, "", i == len(roots)-1, directChangedPackages)
+		p.printTreeNode(w, root, "", i == len(roots)-1, directChangedPackages)
 	}
 }
 
-func (p *explainPrinter) printTreeNode(node *treeNode, prefix string, isLast bool, highlight map[string]struct{}) {
+func (p *explainPrinter) printTreeNode(w io.Writer, node *treeNode, prefix string, isLast bool, highlight map[string]struct{}) {
 	treeSymbol := "├──"
 	if isLast {
 		treeSymbol = "└──"
 	}
 
 	packageName := node.PackageName
-	if _, isDirectChange := highlight[node.PackageName]; isDirectChange {
+	if _, isDirectChange := highlight[node.PackageName]; isDirectChange && p.color {
 		packageName = fmt.Sprintf("\033[32m%s\033[0m", packageName) // ANSI escape code for green
 	}
 
-	fmt.Printf("%s%s %s\n", prefix, treeSymbol, packageName)
+	if node.Version != "" {
+		packageName += fmt.Sprintf(" (%s)", node.Version)
+	}
+
+	if node.Kind != "" && node.Kind != rippler.ImportRegular {
+		packageName += fmt.Sprintf(" [%s]", node.Kind)
+	}
+
+	if node.Seen {
+		packageName += " (see above)"
+	}
+
+	fmt.Fprintf(w, "%s%s %s\n", prefix, treeSymbol, packageName)
 
 	childPrefix := prefix
 
@@ -91,37 +136,61 @@ func (p *explainPrinter) printTreeNode(node *treeNode, prefix string, isLast boo
 	childPrefix += appendix
 
 	for i, child := range node.Children {
-		p.printTreeNode(child, childPrefix, i == len(node.Children)-1, highlight)
+		p.printTreeNode(w, child, childPrefix, i == len(node.Children)-1, highlight)
 	}
 }
 
+// buildTree builds the reverse dependency tree of the affected packages, rooted at the direct
+// changes. The graph being a DAG, a package reachable from several changes is shown, with its
+// dependents, the first time only, and as a back-reference afterwards.
 func (p *explainPrinter) buildTree(report *rippler.Report) []*treeNode {
-	dependencyMap := make(map[string][]string)
+	edges := report.DependentEdges()
 
-	for i := range report.AllPackages {
-		allImports := append(append(report.AllPackages[i].Imports, report.AllPackages[i].TestImports...), report.AllPackages[i].XTestImports...)
+	affected := make(map[string]struct{})
+	for i := range report.AffectedPackages {
+		affected[report.AffectedPackages[i].ImportPath] = struct{}{}
+	}
+
+	// With a focus, only the packages leading to it are shown.
+	var reaching map[string]struct{}
+	if p.focus != "" {
+		reaching = report.PackagesReaching(report.ResolvePackage(p.focus))
+	}
 
-		for _, imported := range allImports {
-			dependencyMap[imported] = append(dependencyMap[imported], report.AllPackages[i].ImportPath)
+	shown := func(pkg string) bool {
+		if _, ok := reaching[pkg]; reaching != nil && !ok {
+			return false
 		}
+
+		_, ok := affected[pkg]
+
+		return ok
 	}
 
-	// cycles detector.
-	visited := make(map[string]bool)
+	expanded := make(map[string]bool)
+
+	var buildTreeNode func(pkg string, kind rippler.ImportKind) *treeNode
 
-	var buildTreeNode func(pkg string) *treeNode
+	buildTreeNode = func(pkg string, kind rippler.ImportKind) *treeNode {
+		node := &treeNode{PackageName: pkg, Kind: kind}
 
-	buildTreeNode = func(pkg string) *treeNode {
-		if visited[pkg] {
-			return nil
+		if expanded[pkg] {
+			node.Seen = true
+
+			return node
 		}
 
-		visited[pkg] = true
-		node := &treeNode{PackageName: pkg}
+		expanded[pkg] = true
+
+		dependents := slices.Clone(edges[pkg])
+		slices.SortFunc(dependents, func(a, b rippler.ImportEdge) int {
+			return strings.Compare(a.Importer, b.Importer)
+		})
 
-		for _, dependent := range dependencyMap[pkg] {
-			if child := buildTreeNode(dependent); child != nil {
-				node.Children = append(node.Children, child)
+		for _, edge := range dependents {
+			// External test packages importing the package they test are not dependents.
+			if edge.Importer != pkg && shown(edge.Importer) {
+				node.Children = append(node.Children, buildTreeNode(edge.Importer, edge.Kind))
 			}
 		}
 
@@ -130,11 +199,90 @@ func (p *explainPrinter) buildTree(report *rippler.Report) []*treeNode {
 
 	var roots []*treeNode
 
-	for i := range report.Changes {
-		if root := buildTreeNode(report.Changes[i].PackageName); root != nil {
-			roots = append(roots, root)
+	for _, change := range rootChanges(report, edges) {
+		var root *treeNode
+
+		if strings.HasSuffix(change.PackageName, "/...") {
+			// Whole-module changes are rooted at every package they directly affect.
+			root = &treeNode{PackageName: change.PackageName}
+
+			for j := range report.AffectedPackages {
+				pkg := report.AffectedPackages[j]
+
+				if pkg.Distance == 0 && pkg.Origin == change.PackageName && shown(pkg.ImportPath) {
+					root.Children = append(root.Children, buildTreeNode(pkg.ImportPath, ""))
+				}
+			}
+		} else if shown(change.PackageName) {
+			root = buildTreeNode(change.PackageName, "")
 		}
+
+		if root == nil || (strings.HasSuffix(root.PackageName, "/...") && len(root.Children) == 0) {
+			continue
+		}
+
+		root.Version = moduleVersionDelta(change)
+		roots = append(roots, root)
 	}
 
 	return roots
 }
+
+// rootChanges sorts the direct changes so that those depending on no other change come
+// first, the others then being shown as back-references within their trees.
+func rootChanges(report *rippler.Report, edges map[string][]rippler.ImportEdge) []rippler.Change {
+	downstream := make(map[string]bool)
+
+	for i := range report.Changes {
+		queue := []string{report.Changes[i].PackageName}
+		visited := map[string]bool{report.Changes[i].PackageName: true}
+
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			for _, edge := range edges[current] {
+				if !visited[edge.Importer] {
+					visited[edge.Importer] = true
+					downstream[edge.Importer] = true
+					queue = append(queue, edge.Importer)
+				}
+			}
+		}
+	}
+
+	changes := slices.Clone(report.Changes)
+	slices.SortFunc(changes, func(a, b rippler.Change) int {
+		if downstream[a.PackageName] != downstream[b.PackageName] {
+			if downstream[a.PackageName] {
+				return 1
+			}
+
+			return -1
+		}
+
+		return strings.Compare(a.PackageName, b.PackageName)
+	})
+
+	return changes
+}
+
+// moduleVersionDelta describes the version change of an external module change, if any.
+func moduleVersionDelta(change rippler.Change) string {
+	for _, reason := range change.Reasons {
+		if reason.Module == "" || (reason.OldVersion == "" && reason.NewVersion == "") {
+			continue
+		}
+
+		switch {
+		case reason.OldVersion == "":
+			return "added " + reason.NewVersion
+		case reason.NewVersion == "":
+			return "removed " + reason.OldVersion
+		default:
+			return reason.OldVersion + " -> " + reason.NewVersion
+		}
+	}
+
+	return ""
+}
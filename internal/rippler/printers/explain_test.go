@@ -0,0 +1,81 @@
+package printers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestExplainTree(t *testing.T) {
+	const module = "example.com/project"
+
+	report := &rippler.Report{
+		GoMod: model.GoMod{Module: model.GoModDependency{Path: module}},
+		AllPackages: []model.Package{
+			{ImportPath: module + "/pkg/strutil"},
+			{ImportPath: module + "/internal/db", Imports: []string{module + "/pkg/strutil"}},
+			{ImportPath: module + "/internal/cache", Imports: []string{module + "/pkg/strutil"}},
+			{ImportPath: module + "/cmd/api", Imports: []string{module + "/internal/db", module + "/internal/cache"}},
+			{ImportPath: module + "/cmd/worker", TestImports: []string{module + "/pkg/strutil"}},
+		},
+		Changes: []rippler.Change{
+			{PackageName: module + "/internal/db"},
+			{PackageName: module + "/pkg/strutil"},
+		},
+	}
+
+	for _, pkg := range report.AllPackages {
+		report.AffectedPackages = append(report.AffectedPackages, model.AffectedPackage{ImportPath: pkg.ImportPath})
+	}
+
+	tests := []struct {
+		name  string
+		focus string
+		want  string
+	}{
+		{
+			name: "whole tree",
+			want: `├── example.com/project/pkg/strutil
+│   ├── example.com/project/cmd/worker [test import]
+│   ├── example.com/project/internal/cache
+│   │   └── example.com/project/cmd/api
+│   └── example.com/project/internal/db
+│       └── example.com/project/cmd/api (see above)
+└── example.com/project/internal/db (see above)
+`,
+		},
+		{
+			name:  "focus",
+			focus: "./cmd/api",
+			want: `├── example.com/project/pkg/strutil
+│   ├── example.com/project/internal/cache
+│   │   └── example.com/project/cmd/api
+│   └── example.com/project/internal/db
+│       └── example.com/project/cmd/api (see above)
+└── example.com/project/internal/db (see above)
+`,
+		},
+		{
+			name:  "focus on a test dependent",
+			focus: "cmd/worker",
+			want: `└── example.com/project/pkg/strutil
+    └── example.com/project/cmd/worker [test import]
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &explainPrinter{focus: tt.focus}
+
+			b := strings.Builder{}
+			p.tree(&b, report)
+
+			if got := b.String(); got != tt.want {
+				t.Errorf("tree() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
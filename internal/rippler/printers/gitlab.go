@@ -0,0 +1,169 @@
+package printers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/template"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultGitLabJobTemplate is the job template used when none is given: it tests the
+// packages of the group from the module directory, jobs starting at the repository root.
+const DefaultGitLabJobTemplate = `script:
+  - {{ if .Context }}cd {{ .Context }} && {{ end }}go test {{ join " " .Packages }}
+`
+
+// gitLabNoopJob is the only job of the child pipeline when no package is affected, as
+// GitLab rejects empty pipelines.
+const gitLabNoopJob = `script:
+  - echo "No affected packages, nothing to test."
+`
+
+type gitLabPipelinePrinter struct {
+	grouping    Grouping
+	jobTemplate string
+}
+
+// jobData is the data the job templates of the pipeline printers are rendered with.
+type jobData struct {
+	// Name is the name of the group, e.g. "shard-1", an application name or a module path.
+	Name string
+
+	// Packages are the import paths of the packages of the group.
+	Packages []string
+
+	// Dirs are the directories of the packages of the group, relative to the module root,
+	// e.g. "./users". For module groups, that is the root of the module of the group.
+	Dirs []string
+
+	// Context is the module directory relative to the repository root, empty when both are
+	// the same. For module groups, that is the directory of the module of the group.
+	Context string
+}
+
+// NewGitLabPipelinePrinter creates a new instance of the GitLab pipeline printer, which writes
+// a YAML child pipeline with one job per group of affected packages. The job template is a
+// text/template rendering the YAML body of each job (script, image, tags...), with the same
+// helper functions as the template output, see jobData for the available fields.
+func NewGitLabPipelinePrinter(grouping Grouping, jobTemplate string) (rippler.ReportPrinter, error) {
+	if jobTemplate == "" {
+		jobTemplate = DefaultGitLabJobTemplate
+	}
+
+	if _, err := template.New("job").Funcs(templateFuncs(&rippler.Report{})).Parse(jobTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse job template: %w", err)
+	}
+
+	return &gitLabPipelinePrinter{grouping: grouping, jobTemplate: jobTemplate}, nil
+}
+
+// Print prints the child pipeline in YAML format.
+func (g *gitLabPipelinePrinter) Print(w io.Writer, report *rippler.Report) error {
+	groups, err := g.grouping.groups(report)
+	if err != nil {
+		return err
+	}
+
+	tpl, err := template.New("job").Funcs(templateFuncs(report)).Parse(g.jobTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse job template: %w", err)
+	}
+
+	pipeline := &yaml.Node{Kind: yaml.MappingNode}
+
+	if len(groups) == 0 {
+		job, yErr := yamlMapping(gitLabNoopJob)
+		if yErr != nil {
+			return yErr
+		}
+
+		addYAMLEntry(pipeline, "ripple:noop", job)
+	}
+
+	for _, group := range groups {
+		body := bytes.Buffer{}
+		if eErr := tpl.Execute(&body, newJobData(report, group)); eErr != nil {
+			return fmt.Errorf("failed to render job template for %s: %w", group.Name, eErr)
+		}
+
+		job, yErr := yamlMapping(body.String())
+		if yErr != nil {
+			return fmt.Errorf("invalid job for %s: %w", group.Name, yErr)
+		}
+
+		addYAMLEntry(pipeline, "test:"+group.Name, job)
+	}
+
+	return writeYAML(w, pipeline)
+}
+
+// newJobData builds the job template data of a group of packages. The directories of module
+// groups are relative to their own module.
+func newJobData(report *rippler.Report, group packageGroup) jobData {
+	if group.ModuleDir != "" && group.ModuleDir != report.ModuleDir {
+		scoped := *report
+		scoped.ModuleDir = group.ModuleDir
+		report = &scoped
+	}
+
+	dirs := make(map[string]string)
+	for i := range report.AllPackages {
+		dirs[report.AllPackages[i].ImportPath] = moduleRelative(report, report.AllPackages[i].Dir)
+	}
+
+	data := jobData{
+		Name:     group.Name,
+		Packages: group.Packages,
+		Dirs:     make([]string, 0, len(group.Packages)),
+		Context:  buildContext(report),
+	}
+
+	for _, pkg := range group.Packages {
+		data.Dirs = append(data.Dirs, dirs[pkg])
+	}
+
+	return data
+}
+
+// yamlMapping parses a YAML mapping, e.g. a rendered job template.
+func yamlMapping(text string) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(text), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("expected a YAML mapping")
+	}
+
+	return doc.Content[0], nil
+}
+
+// addYAMLEntry adds a key and its value to a YAML mapping node.
+func addYAMLEntry(mapping *yaml.Node, key string, value *yaml.Node) {
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
+
+// writeYAML writes a YAML document with the conventional two-space indentation.
+func writeYAML(w io.Writer, node *yaml.Node) error {
+	out := bytes.Buffer{}
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(node); err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+
+	if _, err := out.WriteTo(w); err != nil {
+		return fmt.Errorf("failed to write YAML: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package printers
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+// nodeKind tells how a node of the affected graph came to be affected.
+type nodeKind int
+
+const (
+	// nodePropagated is a project package affected through its imports.
+	nodePropagated nodeKind = iota
+
+	// nodeDirect is a project package directly changed.
+	nodeDirect
+
+	// nodeExternal is a third-party module or package that changed.
+	nodeExternal
+
+	// nodeSummary stands for several nodes left out of a graph too large to render.
+	nodeSummary
+)
+
+// graphNode is a node of the affected graph, either a package or a group of packages.
+type graphNode struct {
+	ID    string
+	Label string
+	Kind  nodeKind
+
+	// Size is the number of packages behind this node, greater than one once collapsed.
+	Size int
+}
+
+// graphEdge links a changed package (From) to a package importing it (To), so edges
+// follow the direction in which changes ripple.
+type graphEdge struct {
+	From string
+	To   string
+
+	// Test tells whether the import only happens from test files.
+	Test bool
+}
+
+// affectedGraph returns the subgraph of the project covering the directly changed packages,
+// their transitive dependents and the import edges between them. Whole-module changes, such
+// as global triggers, are not nodes of their own: the packages they cover are direct changes.
+// Nodes are sorted by ID, and edges by their ends.
+func affectedGraph(report *rippler.Report) ([]graphNode, []graphEdge) {
+	direct := make(map[string]struct{})
+	for i := range report.Changes {
+		if !strings.HasSuffix(report.Changes[i].PackageName, "/...") {
+			direct[report.Changes[i].PackageName] = struct{}{}
+		}
+	}
+
+	project := make(map[string]struct{})
+	for i := range report.AllPackages {
+		project[report.AllPackages[i].ImportPath] = struct{}{}
+	}
+
+	nodes := make(map[string]graphNode)
+
+	add := func(pkg string) {
+		kind := nodePropagated
+
+		if _, ok := project[pkg]; !ok {
+			kind = nodeExternal
+		} else if len(report.DirectChanges(pkg)) > 0 {
+			kind = nodeDirect
+		}
+
+		nodes[pkg] = graphNode{ID: pkg, Label: shortPackageName(report, pkg), Kind: kind, Size: 1}
+	}
+
+	for i := range report.AffectedPackages {
+		add(report.AffectedPackages[i].ImportPath)
+	}
+
+	for pkg := range direct {
+		if _, ok := nodes[pkg]; !ok {
+			add(pkg)
+		}
+	}
+
+	edges := make([]graphEdge, 0)
+
+	for i := range report.AllPackages {
+		pkg := report.AllPackages[i]
+
+		if _, ok := nodes[pkg.ImportPath]; !ok {
+			continue
+		}
+
+		regular := make(map[string]struct{})
+
+		for _, imported := range pkg.Imports {
+			if _, ok := nodes[imported]; ok {
+				regular[imported] = struct{}{}
+				edges = append(edges, graphEdge{From: imported, To: pkg.ImportPath})
+			}
+		}
+
+		for _, imported := range append(slices.Clone(pkg.TestImports), pkg.XTestImports...) {
+			if _, ok := regular[imported]; ok || imported == pkg.ImportPath {
+				continue
+			}
+
+			if _, ok := nodes[imported]; ok {
+				regular[imported] = struct{}{}
+				edges = append(edges, graphEdge{From: imported, To: pkg.ImportPath, Test: true})
+			}
+		}
+	}
+
+	return sortedNodes(nodes), sortedEdges(edges)
+}
+
+// collapseGraph merges the nodes of the graph sharing the same group, as returned by the
+// group function. Merged nodes take the most significant kind among their members (direct
+// changes first, then external modules), and edges inside a group are dropped.
+func collapseGraph(nodes []graphNode, edges []graphEdge, group func(graphNode) string) ([]graphNode, []graphEdge) {
+	groups := make(map[string]graphNode)
+	groupOf := make(map[string]string)
+
+	for _, node := range nodes {
+		id := group(node)
+		groupOf[node.ID] = id
+
+		merged, ok := groups[id]
+		if !ok {
+			merged = graphNode{ID: id, Label: id, Kind: node.Kind}
+		} else if node.Kind > merged.Kind {
+			merged.Kind = node.Kind
+		}
+
+		merged.Size += node.Size
+		groups[id] = merged
+	}
+
+	// A group edge is a test edge only when all the edges it stands for are.
+	testOnly := make(map[[2]string]bool)
+
+	for _, edge := range edges {
+		key := [2]string{groupOf[edge.From], groupOf[edge.To]}
+		if key[0] == key[1] {
+			continue
+		}
+
+		if test, ok := testOnly[key]; ok {
+			testOnly[key] = test && edge.Test
+		} else {
+			testOnly[key] = edge.Test
+		}
+	}
+
+	out := make([]graphEdge, 0, len(testOnly))
+	for key, test := range testOnly {
+		out = append(out, graphEdge{From: key[0], To: key[1], Test: test})
+	}
+
+	return sortedNodes(groups), sortedEdges(out)
+}
+
+// shortPackageName returns the import path of a project package relative to the module path,
+// or the import path itself for third-party packages.
+func shortPackageName(report *rippler.Report, pkg string) string {
+	if pkg == report.GoMod.Module.Path {
+		return "."
+	}
+
+	if rest, ok := strings.CutPrefix(pkg, report.GoMod.Module.Path+"/"); ok {
+		return rest
+	}
+
+	return pkg
+}
+
+// topLevelDir returns the first element of the module-relative path of a project package, or
+// the import path itself for third-party packages.
+func topLevelDir(report *rippler.Report, pkg string) string {
+	short := shortPackageName(report, pkg)
+	if short == pkg {
+		return pkg
+	}
+
+	dir, _, _ := strings.Cut(short, "/")
+
+	return dir
+}
+
+func sortedNodes(nodes map[string]graphNode) []graphNode {
+	out := make([]graphNode, 0, len(nodes))
+	for _, node := range nodes {
+		out = append(out, node)
+	}
+
+	slices.SortFunc(out, func(a, b graphNode) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return out
+}
+
+func sortedEdges(edges []graphEdge) []graphEdge {
+	slices.SortFunc(edges, func(a, b graphEdge) int {
+		if c := strings.Compare(a.From, b.From); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.To, b.To)
+	})
+
+	return edges
+}
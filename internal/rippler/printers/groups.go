@@ -0,0 +1,125 @@
+package printers
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+	"github.com/tangelo-labs/go-ripple/internal/shard"
+)
+
+// GroupBy selects how affected packages are split into CI jobs by the pipeline printers.
+type GroupBy string
+
+const (
+	// GroupByShard packs the affected packages into balanced shards, as the test-matrix output does.
+	GroupByShard GroupBy = "shard"
+
+	// GroupByApplication makes one group per affected application, plus a "shared" group
+	// for the packages no application depends on.
+	GroupByApplication GroupBy = "application"
+
+	// GroupByModule makes one group per Go module holding affected packages, e.g. the
+	// modules of a workspace.
+	GroupByModule GroupBy = "module"
+)
+
+// Grouping tells how the pipeline printers split the affected packages into jobs.
+type Grouping struct {
+	// By is the grouping strategy.
+	By GroupBy
+
+	// Shards, Balance and Timings drive the shard grouping, see NewTestMatrixPrinter.
+	Shards  int
+	Balance MatrixBalance
+	Timings *shard.Timings
+}
+
+// packageGroup is a set of affected packages processed by a single CI job.
+type packageGroup struct {
+	// Name identifies the group, e.g. "shard-1", an application name or a module path.
+	Name string
+
+	// Packages are the import paths of the affected project packages of the group.
+	Packages []string
+
+	// ModuleDir is the directory of the module holding the packages of a module group, empty
+	// for the other groups.
+	ModuleDir string
+}
+
+// groups splits the affected packages of the project into groups. Affected third-party
+// packages are left out, as they are not tested by the project. Empty groups are omitted.
+func (g Grouping) groups(report *rippler.Report) ([]packageGroup, error) {
+	packages := make(map[string]model.Package)
+	for i := range report.AllPackages {
+		packages[report.AllPackages[i].ImportPath] = report.AllPackages[i]
+	}
+
+	project := func(pkgs []string) []string {
+		out := make([]string, 0, len(pkgs))
+		for _, pkg := range pkgs {
+			if _, ok := packages[pkg]; ok {
+				out = append(out, pkg)
+			}
+		}
+
+		return out
+	}
+
+	out := make([]packageGroup, 0)
+	add := func(name string, pkgs []string) {
+		if len(pkgs) > 0 {
+			out = append(out, packageGroup{Name: name, Packages: pkgs})
+		}
+	}
+
+	switch g.By {
+	case GroupByShard:
+		matrix := &testMatrixPrinter{shards: g.Shards, balance: g.Balance, timings: g.Timings}
+
+		for i, s := range shard.Balance(matrix.items(report), g.Shards) {
+			add(fmt.Sprintf("shard-%d", i+1), s.Items)
+		}
+	case GroupByApplication:
+		for i := range report.Applications {
+			add(report.Applications[i].Name, project(report.Applications[i].AffectedPackages))
+		}
+
+		add("shared", project(sharedPackages(report)))
+	case GroupByModule:
+		modules := make([]string, 0)
+		byModule := make(map[string][]string)
+		moduleDirs := make(map[string]string)
+
+		for i := range report.AffectedPackages {
+			pkg, ok := packages[report.AffectedPackages[i].ImportPath]
+			if !ok {
+				continue
+			}
+
+			module, dir := report.GoMod.Module.Path, report.ModuleDir
+			if pkg.Module != nil {
+				module, dir = pkg.Module.Path, pkg.Module.Dir
+			}
+
+			if _, seen := byModule[module]; !seen {
+				modules = append(modules, module)
+				moduleDirs[module] = dir
+			}
+
+			byModule[module] = append(byModule[module], pkg.ImportPath)
+		}
+
+		slices.Sort(modules)
+
+		for _, module := range modules {
+			out = append(out, packageGroup{Name: module, Packages: byModule[module], ModuleDir: moduleDirs[module]})
+		}
+	default:
+		return nil, fmt.Errorf("invalid grouping: %s. Valid options are: %s, %s, %s", g.By, GroupByShard, GroupByApplication, GroupByModule)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,73 @@
+package printers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+// newWorkspaceReport returns the report of a workspace made of the example.com/project module,
+// at the repository root, and of the example.com/project/tools module, in its tools directory.
+func newWorkspaceReport(affected ...string) *rippler.Report {
+	project := &model.PackageModule{Path: "example.com/project", Dir: "/repo", Main: true}
+	tools := &model.PackageModule{Path: "example.com/project/tools", Dir: "/repo/tools", Main: true}
+
+	report := &rippler.Report{
+		GoMod:         model.GoMod{Module: model.GoModDependency{Path: project.Path}},
+		RepositoryDir: "/repo",
+		ModuleDir:     "/repo",
+		AllPackages: []model.Package{
+			{ImportPath: "example.com/project/users", Dir: "/repo/users", Module: project},
+			{ImportPath: "example.com/project/api", Dir: "/repo/api", Module: project},
+			{ImportPath: "example.com/project/tools/lint", Dir: "/repo/tools/lint", Module: tools},
+		},
+	}
+
+	for _, pkg := range affected {
+		report.AffectedPackages = append(report.AffectedPackages, model.AffectedPackage{ImportPath: pkg})
+	}
+
+	return report
+}
+
+func TestGroupsByModule(t *testing.T) {
+	report := newWorkspaceReport(
+		"example.com/project/api",
+		"example.com/project/tools/lint",
+		"example.com/project/users",
+		"github.com/x/y",
+	)
+
+	got, err := Grouping{By: GroupByModule}.groups(report)
+	if err != nil {
+		t.Fatalf("groups() error = %v", err)
+	}
+
+	want := []packageGroup{
+		{Name: "example.com/project", Packages: []string{"example.com/project/api", "example.com/project/users"}, ModuleDir: "/repo"},
+		{Name: "example.com/project/tools", Packages: []string{"example.com/project/tools/lint"}, ModuleDir: "/repo/tools"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("groups() = %+v, want %+v", got, want)
+	}
+
+	wantData := []jobData{
+		{Name: "example.com/project", Packages: want[0].Packages, Dirs: []string{"./api", "./users"}},
+		{Name: "example.com/project/tools", Packages: want[1].Packages, Dirs: []string{"./lint"}, Context: "tools"},
+	}
+
+	for i := range got {
+		if data := newJobData(report, got[i]); !reflect.DeepEqual(data, wantData[i]) {
+			t.Errorf("newJobData(%s) = %+v, want %+v", got[i].Name, data, wantData[i])
+		}
+	}
+}
+
+func TestGroupsInvalid(t *testing.T) {
+	if _, err := (Grouping{By: "package"}).groups(newWorkspaceReport()); err == nil {
+		t.Error("groups() error = nil, want an invalid grouping error")
+	}
+}
@@ -0,0 +1,188 @@
+package printers
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+type htmlPrinter struct{}
+
+// htmlReport is the data rendered by the HTML template.
+type htmlReport struct {
+	Title         string
+	Module        string
+	Headline      string
+	Packages      []htmlPackage
+	Trees         []*treeNode
+	Direct        map[string]bool
+	Files         []htmlFile
+	ModuleChanges []rippler.ModuleChange
+}
+
+type htmlPackage struct {
+	ImportPath string
+	Direct     bool
+	Indirect   bool
+}
+
+type htmlFile struct {
+	Path    string
+	Reasons []string
+	Ignored string
+}
+
+// NewHTMLPrinter creates a new instance of the HTML printer, which renders the report as a single
+// self-contained page (inline CSS and JS, no external assets) that can be stored as a CI artifact.
+func NewHTMLPrinter() rippler.ReportPrinter {
+	return &htmlPrinter{}
+}
+
+// Print prints the report as an HTML page.
+func (h *htmlPrinter) Print(w io.Writer, report *rippler.Report) error {
+	tpl, err := template.New("report").Parse(htmlTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse HTML template: %w", err)
+	}
+
+	out := bytes.Buffer{}
+	if eErr := tpl.Execute(&out, h.data(report)); eErr != nil {
+		return fmt.Errorf("failed to render HTML report: %w", eErr)
+	}
+
+	if _, wErr := out.WriteTo(w); wErr != nil {
+		return fmt.Errorf("failed to write HTML report: %w", wErr)
+	}
+
+	return nil
+}
+
+func (h *htmlPrinter) data(report *rippler.Report) htmlReport {
+	data := htmlReport{
+		Title:         "go-ripple report for " + report.GoMod.Module.Path,
+		Module:        report.GoMod.Module.Path,
+		Headline:      markdownHeadline(report),
+		Trees:         (&explainPrinter{}).buildTree(report),
+		Direct:        make(map[string]bool),
+		ModuleChanges: report.ModuleChanges,
+	}
+
+	for i := range report.Changes {
+		data.Direct[report.Changes[i].PackageName] = true
+	}
+
+	for i := range report.AffectedPackages {
+		data.Packages = append(data.Packages, htmlPackage{
+			ImportPath: report.AffectedPackages[i].ImportPath,
+			Direct:     data.Direct[report.AffectedPackages[i].ImportPath],
+			Indirect:   report.AffectedPackages[i].Indirect,
+		})
+	}
+
+	for i := range report.ChangedFiles {
+		file := htmlFile{Path: relativePath(report, report.ChangedFiles[i])}
+
+		for _, change := range report.Changes {
+			for _, reason := range change.Reasons {
+				if reason.File == report.ChangedFiles[i] {
+					file.Reasons = append(file.Reasons, fmt.Sprintf("%s: %s", change.PackageName, relativeReason(report, reason)))
+				}
+			}
+		}
+
+		data.Files = append(data.Files, file)
+	}
+
+	for i := range report.IgnoredFiles {
+		data.Files = append(data.Files, htmlFile{
+			Path:    relativePath(report, report.IgnoredFiles[i].Path),
+			Ignored: report.IgnoredFiles[i].Rule,
+		})
+	}
+
+	slices.SortFunc(data.Files, func(a, b htmlFile) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return data
+}
+
+const htmlTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem auto; max-width: 72rem; padding: 0 1rem; color: #1f2328; }
+  h1 { font-size: 1.5rem; } h2 { font-size: 1.2rem; border-bottom: 1px solid #d0d7de; padding-bottom: .3rem; margin-top: 2rem; }
+  code, .pkg { font-family: ui-monospace, SFMono-Regular, Menlo, monospace; font-size: .9em; }
+  table { border-collapse: collapse; width: 100%; } th, td { text-align: left; padding: .35rem .6rem; border-bottom: 1px solid #eaeef2; vertical-align: top; }
+  input[type=search] { width: 100%; padding: .5rem; font-size: 1rem; margin-bottom: .5rem; box-sizing: border-box; }
+  ul.packages { list-style: none; padding: 0; columns: 2; } ul.packages li { padding: .1rem 0; }
+  .direct { color: #1a7f37; font-weight: bold; } .external { color: #6e7781; font-style: italic; } .muted { color: #6e7781; }
+  ul.tree, ul.tree ul { list-style: none; padding-left: 1.2rem; } ul.tree { padding-left: 0; }
+  ul.tree summary { cursor: pointer; } ul.tree li.leaf { padding-left: 1rem; }
+  .badge { font-size: .75em; border-radius: 1em; padding: 0 .5em; background: #ddf4ff; color: #0969da; margin-left: .4em; }
+</style>
+</head>
+<body>
+<h1>{{.Headline}}</h1>
+<p class="muted">Module <code>{{.Module}}</code></p>
+
+<h2>Affected packages ({{len .Packages}})</h2>
+<input type="search" id="filter" placeholder="Filter packages…" autofocus>
+<ul class="packages" id="packages">
+{{- range .Packages}}
+  <li class="pkg{{if .Direct}} direct{{else if .Indirect}} external{{end}}">{{.ImportPath}}</li>
+{{- end}}
+</ul>
+
+<h2>Reverse-dependency tree</h2>
+<p class="muted">Directly changed packages, and the packages importing them. Click to expand.</p>
+<ul class="tree">
+{{- range .Trees}}{{template "node" .}}{{end}}
+</ul>
+
+<h2>Changed files ({{len .Files}})</h2>
+<table>
+<tr><th>File</th><th>Reasons</th></tr>
+{{- range .Files}}
+<tr><td><code>{{.Path}}</code></td><td>{{if .Ignored}}<span class="muted">ignored by rule <code>{{.Ignored}}</code></span>{{else}}{{range .Reasons}}<div>{{.}}</div>{{else}}<span class="muted">no package change</span>{{end}}{{end}}</td></tr>
+{{- end}}
+</table>
+
+{{if .ModuleChanges -}}
+<h2>Module version changes ({{len .ModuleChanges}})</h2>
+<table>
+<tr><th>Module</th><th>Old</th><th>New</th></tr>
+{{- range .ModuleChanges}}
+<tr><td><code>{{.Path}}</code>{{if .Indirect}} <span class="badge">indirect</span>{{end}}</td><td>{{with .OldVersion}}<code>{{.}}</code>{{else}}<em>added</em>{{end}}</td><td>{{with .NewVersion}}<code>{{.}}</code>{{else}}<em>removed</em>{{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+
+<script>
+  document.getElementById("filter").addEventListener("input", function (e) {
+    var q = e.target.value.toLowerCase();
+    document.querySelectorAll("#packages li").forEach(function (li) {
+      li.style.display = li.textContent.toLowerCase().indexOf(q) === -1 ? "none" : "";
+    });
+  });
+</script>
+</body>
+</html>
+{{define "node"}}
+{{- if .Children}}<li><details open><summary class="pkg">{{template "label" .}}</summary><ul>{{range .Children}}{{template "node" .}}{{end}}</ul></details></li>
+{{- else}}<li class="leaf pkg">{{template "label" .}}</li>{{end}}
+{{- end}}
+{{define "label"}}{{.PackageName}}
+{{- if .Version}} ({{.Version}}){{end}}
+{{- if and .Kind (ne (print .Kind) "import")}} [{{.Kind}}]{{end}}
+{{- if .Seen}} (see above){{end}}
+{{- end}}`
@@ -0,0 +1,54 @@
+package printers
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestHTMLPrinter(t *testing.T) {
+	const module = "example.com/project"
+
+	report := &rippler.Report{
+		GoMod:         model.GoMod{Module: model.GoModDependency{Path: module}},
+		RepositoryDir: "/repo",
+		ChangedFiles:  []string{"/repo/users/user.go", "/repo/README.md"},
+		IgnoredFiles:  []rippler.IgnoredFile{{Path: "/repo/docs/<guide>.md", Rule: "docs/**"}},
+		AllPackages:   []model.Package{{ImportPath: module + "/users"}},
+		Changes: []rippler.Change{{
+			PackageName: module + "/users",
+			Reasons:     []rippler.Reason{{Kind: rippler.ReasonFile, File: "/repo/users/user.go"}},
+		}},
+		AffectedPackages: []model.AffectedPackage{{ImportPath: module + "/users"}},
+	}
+
+	wantFiles := []htmlFile{
+		{Path: "README.md"},
+		{Path: "docs/<guide>.md", Ignored: "docs/**"},
+		{Path: "users/user.go", Reasons: []string{module + "/users: file users/user.go has changed"}},
+	}
+
+	if got := (&htmlPrinter{}).data(report).Files; !reflect.DeepEqual(got, wantFiles) {
+		t.Errorf("data() files = %+v, want %+v", got, wantFiles)
+	}
+
+	var out bytes.Buffer
+	if err := NewHTMLPrinter().Print(&out, report); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+
+	page := out.String()
+
+	if external := regexp.MustCompile(`(?:src|href)="(?:https?:)?//`).FindString(page); external != "" {
+		t.Errorf("Print() references an external asset: %s", external)
+	}
+
+	if strings.Contains(page, "<guide>") || !strings.Contains(page, "&lt;guide&gt;") {
+		t.Error("Print() does not escape file paths")
+	}
+}
@@ -3,6 +3,7 @@ package printers
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/tangelo-labs/go-ripple/internal/rippler"
 )
@@ -15,13 +16,15 @@ func NewJSONPrinter() rippler.ReportPrinter {
 }
 
 // Print prints the affected packages in JSON format.
-func (j *jsonPrinter) Print(report *rippler.Report) error {
+func (j *jsonPrinter) Print(w io.Writer, report *rippler.Report) error {
 	jsonData, err := json.MarshalIndent(report.AffectedPackages, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	fmt.Println(string(jsonData))
+	if _, err := fmt.Fprintln(w, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
 
 	return nil
 }
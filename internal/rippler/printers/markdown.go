@@ -0,0 +1,204 @@
+package printers
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+// markdownMaxBytes keeps the markdown summary below the size limit of pull request comments
+// (65536 characters on GitHub), leaving some room for bots to add their own content.
+const markdownMaxBytes = 60000
+
+type markdownPrinter struct{}
+
+// NewMarkdownPrinter creates a new instance of the markdown printer, which renders a summary of
+// the report suitable for a pull request comment.
+func NewMarkdownPrinter() rippler.ReportPrinter {
+	return &markdownPrinter{}
+}
+
+// Print prints the report summary in GitHub-flavored markdown.
+func (m *markdownPrinter) Print(w io.Writer, report *rippler.Report) error {
+	if _, err := io.WriteString(w, markdownSummary(report)); err != nil {
+		return fmt.Errorf("failed to write markdown summary: %w", err)
+	}
+
+	return nil
+}
+
+// markdownSummary renders the report as markdown: summary counts, direct changes, module
+// changes and the collapsible list of affected packages grouped by top-level directory.
+// Long lists are truncated so the result stays within markdownMaxBytes.
+func markdownSummary(report *rippler.Report) string {
+	b := strings.Builder{}
+
+	fmt.Fprintf(&b, "### :ocean: %s\n\n", markdownHeadline(report))
+
+	if len(report.AffectedPackages) == 0 {
+		b.WriteString("No package is affected by these changes.\n")
+
+		return b.String()
+	}
+
+	b.WriteString("| | Count |\n|---|---:|\n")
+	fmt.Fprintf(&b, "| Directly changed packages | %d |\n", len(report.Changes))
+	fmt.Fprintf(&b, "| Affected packages | %d |\n", len(report.AffectedPackages))
+	fmt.Fprintf(&b, "| Affected applications | %d |\n", len(report.Applications))
+	fmt.Fprintf(&b, "| Module changes | %d |\n", len(report.ModuleChanges))
+	fmt.Fprintf(&b, "| Changed files | %d |\n", len(report.ChangedFiles))
+	b.WriteString("\n")
+
+	// Each section gets a share of the remaining budget, the package list coming last.
+	sections := []func(budget int) string{
+		func(budget int) string { return markdownChanges(report, budget) },
+		func(budget int) string { return markdownModules(report, budget) },
+		func(budget int) string { return markdownPackages(report, budget) },
+	}
+
+	for i, section := range sections {
+		budget := (markdownMaxBytes - b.Len()) / (len(sections) - i)
+		b.WriteString(section(budget))
+	}
+
+	return b.String()
+}
+
+func markdownHeadline(report *rippler.Report) string {
+	headline := fmt.Sprintf("This change affects %s", plural(len(report.AffectedPackages), "package", "packages"))
+
+	if len(report.Applications) > 0 {
+		headline += fmt.Sprintf(" across %s", plural(len(report.Applications), "application", "applications"))
+	}
+
+	return headline
+}
+
+func markdownChanges(report *rippler.Report, budget int) string {
+	if len(report.Changes) == 0 {
+		return ""
+	}
+
+	changes := slices.Clone(report.Changes)
+	slices.SortFunc(changes, func(a, b rippler.Change) int {
+		return strings.Compare(a.PackageName, b.PackageName)
+	})
+
+	rows := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		reasons := make([]string, 0, len(change.Reasons))
+		for _, reason := range change.Reasons {
+			reasons = append(reasons, markdownCell(relativeReason(report, reason)))
+		}
+
+		rows = append(rows, fmt.Sprintf("| `%s` | %s |\n", shortPackageName(report, change.PackageName), strings.Join(reasons, "<br>")))
+	}
+
+	return "#### Direct changes\n\n| Package | Reasons |\n|---|---|\n" +
+		markdownLines(rows, budget, func(n int) string { return fmt.Sprintf("| … | and %d more |\n", n) }) + "\n"
+}
+
+func markdownModules(report *rippler.Report, budget int) string {
+	if len(report.ModuleChanges) == 0 {
+		return ""
+	}
+
+	rows := make([]string, 0, len(report.ModuleChanges))
+
+	for _, mod := range report.ModuleChanges {
+		name := fmt.Sprintf("`%s`", mod.Path)
+		if mod.Indirect {
+			name += " (indirect)"
+		}
+
+		rows = append(rows, fmt.Sprintf("| %s | %s | %s |\n", name, markdownVersion(mod.OldVersion, "added"), markdownVersion(mod.NewVersion, "removed")))
+	}
+
+	return "#### Dependency module changes\n\n| Module | Old | New |\n|---|---|---|\n" +
+		markdownLines(rows, budget, func(n int) string { return fmt.Sprintf("| … | | and %d more |\n", n) }) + "\n"
+}
+
+func markdownPackages(report *rippler.Report, budget int) string {
+	groups := make(map[string][]string)
+	for i := range report.AffectedPackages {
+		dir := topLevelDir(report, report.AffectedPackages[i].ImportPath)
+		groups[dir] = append(groups[dir], shortPackageName(report, report.AffectedPackages[i].ImportPath))
+	}
+
+	dirs := make([]string, 0, len(groups))
+	for dir := range groups {
+		dirs = append(dirs, dir)
+	}
+
+	slices.Sort(dirs)
+
+	lines := make([]string, 0, len(report.AffectedPackages)+len(dirs))
+
+	for _, dir := range dirs {
+		lines = append(lines, fmt.Sprintf("\n**%s** (%d)\n\n", dir, len(groups[dir])))
+
+		for _, pkg := range groups[dir] {
+			lines = append(lines, fmt.Sprintf("- `%s`\n", pkg))
+		}
+	}
+
+	header := fmt.Sprintf("<details>\n<summary>Affected packages (%d)</summary>\n", len(report.AffectedPackages))
+	footer := "\n</details>\n"
+
+	return header + markdownLines(lines, budget-len(header)-len(footer), func(n int) string {
+		return fmt.Sprintf("\n_… and %d more lines, the list is too long for a comment._\n", n)
+	}) + footer
+}
+
+// markdownLines joins as many lines as fit within the budget, followed by the note returned
+// by more for the number of lines left out, if any.
+func markdownLines(lines []string, budget int, more func(int) string) string {
+	b := strings.Builder{}
+
+	for i, line := range lines {
+		if b.Len()+len(line)+len(more(len(lines))) > budget {
+			b.WriteString(more(len(lines) - i))
+
+			break
+		}
+
+		b.WriteString(line)
+	}
+
+	return b.String()
+}
+
+func markdownVersion(version, missing string) string {
+	if version == "" {
+		return "_" + missing + "_"
+	}
+
+	return "`" + version + "`"
+}
+
+// markdownCell escapes the characters that would break a markdown table cell.
+func markdownCell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
+
+// relativeReason describes a change reason with its file relative to the repository root,
+// so it reads well outside of the machine that produced the report.
+func relativeReason(report *rippler.Report, reason rippler.Reason) string {
+	if report.RepositoryDir != "" && reason.File != "" {
+		reason.File = relativePath(report, reason.File)
+	}
+
+	return reason.String()
+}
+
+func plural(n int, singular, pluralForm string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+
+	return fmt.Sprintf("%d %s", n, pluralForm)
+}
@@ -0,0 +1,79 @@
+package printers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestMarkdownSummary(t *testing.T) {
+	const module = "example.com/project"
+
+	report := &rippler.Report{
+		GoMod:         model.GoMod{Module: model.GoModDependency{Path: module}},
+		RepositoryDir: "/repo",
+		ChangedFiles:  []string{"/repo/users/user.go", "/repo/go.mod"},
+		Changes: []rippler.Change{{
+			PackageName: module + "/users",
+			Reasons:     []rippler.Reason{{Kind: rippler.ReasonFile, File: "/repo/users/user.go"}},
+		}},
+		ModuleChanges: []rippler.ModuleChange{{Path: "github.com/lib/pq", NewVersion: "v1.10.9"}},
+		AffectedPackages: []model.AffectedPackage{
+			{ImportPath: module + "/api/http"},
+			{ImportPath: module + "/users"},
+		},
+	}
+
+	want := "### :ocean: This change affects 2 packages\n\n" +
+		"| | Count |\n|---|---:|\n" +
+		"| Directly changed packages | 1 |\n" +
+		"| Affected packages | 2 |\n" +
+		"| Affected applications | 0 |\n" +
+		"| Module changes | 1 |\n" +
+		"| Changed files | 2 |\n\n" +
+		"#### Direct changes\n\n| Package | Reasons |\n|---|---|\n" +
+		"| `users` | file users/user.go has changed |\n\n" +
+		"#### Dependency module changes\n\n| Module | Old | New |\n|---|---|---|\n" +
+		"| `github.com/lib/pq` | _added_ | `v1.10.9` |\n\n" +
+		"<details>\n<summary>Affected packages (2)</summary>\n" +
+		"\n**api** (1)\n\n- `api/http`\n" +
+		"\n**users** (1)\n\n- `users`\n" +
+		"\n</details>\n"
+
+	if got := markdownSummary(report); got != want {
+		t.Errorf("markdownSummary() =\n%s\nwant\n%s", got, want)
+	}
+
+	report.AffectedPackages = nil
+	if got, want := markdownSummary(report), "### :ocean: This change affects 0 packages\n\nNo package is affected by these changes.\n"; got != want {
+		t.Errorf("markdownSummary() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestMarkdownSummaryTruncated(t *testing.T) {
+	const module = "example.com/project"
+
+	report := &rippler.Report{GoMod: model.GoMod{Module: model.GoModDependency{Path: module}}}
+
+	for i := range 10000 {
+		pkg := fmt.Sprintf("%s/services/service%05d", module, i)
+
+		report.Changes = append(report.Changes, rippler.Change{PackageName: pkg, Reasons: []rippler.Reason{{Kind: rippler.ReasonRequested}}})
+		report.AffectedPackages = append(report.AffectedPackages, model.AffectedPackage{ImportPath: pkg})
+	}
+
+	got := markdownSummary(report)
+
+	if len(got) > markdownMaxBytes {
+		t.Errorf("markdownSummary() is %d bytes long, want at most %d", len(got), markdownMaxBytes)
+	}
+
+	for _, want := range []string{"| … | and ", "more lines, the list is too long for a comment._", "</details>\n"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("markdownSummary() does not contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+	"github.com/tangelo-labs/go-ripple/internal/shard"
+)
+
+// MatrixBalance selects how packages are weighted when packing them into test-matrix shards.
+type MatrixBalance string
+
+const (
+	// BalanceByPackages gives every package the same weight.
+	BalanceByPackages MatrixBalance = "packages"
+
+	// BalanceByTestFiles weights packages by their number of test files. Packages without
+	// test files still count as one.
+	BalanceByTestFiles MatrixBalance = "test-files"
+
+	// BalanceByTimings weights packages by their test duration, as learned from previous
+	// `go test -json` runs.
+	BalanceByTimings MatrixBalance = "timings"
+)
+
+type testMatrixPrinter struct {
+	shards  int
+	balance MatrixBalance
+	timings *shard.Timings
+}
+
+// testMatrix is the JSON document produced by the test-matrix printer. Its shape matches
+// the one expected by GitHub Actions, so it can be used as `strategy.matrix` via `fromJSON`.
+type testMatrix struct {
+	Include []testMatrixEntry `json:"include"`
+}
+
+type testMatrixEntry struct {
+	// Name is a human-readable name for the job, e.g. "shard-1".
+	Name string `json:"name"`
+
+	// Packages are the import paths of the packages to process in this job.
+	Packages []string `json:"packages"`
+
+	// Context is the directory, relative to the repository root, the job should run in.
+	// It is omitted when the module lives at the repository root.
+	Context string `json:"context,omitempty"`
+
+	// ExpectedDuration is the estimated test duration of this job, e.g. "2m30s".
+	// It is only set when balancing by timings.
+	ExpectedDuration string `json:"expectedDuration,omitempty"`
+}
+
+// NewTestMatrixPrinter creates a new instance of the test matrix printer, which packs the
+// affected packages of the project into (at most) the given number of balanced shards.
+// Affected third-party packages are left out, as they are not tested by the project.
+// The timings are only used, and required, when balancing by timings.
+func NewTestMatrixPrinter(shards int, balance MatrixBalance, timings *shard.Timings) rippler.ReportPrinter {
+	return &testMatrixPrinter{
+		shards:  shards,
+		balance: balance,
+		timings: timings,
+	}
+}
+
+// Print prints the CI job matrix in JSON format.
+func (t *testMatrixPrinter) Print(w io.Writer, report *rippler.Report) error {
+	matrix := testMatrix{Include: make([]testMatrixEntry, 0)}
+	context := buildContext(report)
+
+	for i, s := range shard.Balance(t.items(report), t.shards) {
+		entry := testMatrixEntry{
+			Name:     fmt.Sprintf("shard-%d", i+1),
+			Packages: s.Items,
+			Context:  context,
+		}
+
+		if t.balance == BalanceByTimings {
+			entry.ExpectedDuration = time.Duration(s.Weight * float64(time.Second)).Round(100 * time.Millisecond).String()
+		}
+
+		matrix.Include = append(matrix.Include, entry)
+	}
+
+	jsonData, err := json.MarshalIndent(matrix, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(w, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to write test matrix: %w", err)
+	}
+
+	return nil
+}
+
+// items weights every affected package of the project according to the balance strategy.
+func (t *testMatrixPrinter) items(report *rippler.Report) []shard.Item {
+	packages := make(map[string]model.Package)
+	for i := range report.AllPackages {
+		packages[report.AllPackages[i].ImportPath] = report.AllPackages[i]
+	}
+
+	items := make([]shard.Item, 0)
+
+	for i := range report.AffectedPackages {
+		pkg, ok := packages[report.AffectedPackages[i].ImportPath]
+		if !ok || report.AffectedPackages[i].Indirect {
+			continue
+		}
+
+		weight := 1.0
+
+		switch t.balance {
+		case BalanceByTestFiles:
+			weight = float64(max(1, len(pkg.TestGoFiles)+len(pkg.XTestGoFiles)))
+		case BalanceByTimings:
+			weight = t.timings.Estimate(pkg.ImportPath).Seconds()
+		}
+
+		items = append(items, shard.Item{
+			Key:    pkg.ImportPath,
+			Weight: weight,
+		})
+	}
+
+	return items
+}
+
+// buildContext returns the module directory relative to the repository root, or an empty
+// string when both are the same.
+func buildContext(report *rippler.Report) string {
+	rel, err := filepath.Rel(report.RepositoryDir, report.ModuleDir)
+	if err != nil || rel == "." {
+		return ""
+	}
+
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,220 @@
+package printers
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+type mermaidPrinter struct {
+	collapse GraphCollapse
+	maxNodes int
+}
+
+// NewMermaidPrinter creates a new instance of the Mermaid printer, which renders the graph
+// going from the changed packages to their dependents, as rendered inline by GitHub and
+// GitLab. When the graph has more than maxNodes nodes (zero meaning no limit), the nodes
+// farthest from the changes are summarized by top-level directory.
+func NewMermaidPrinter(collapse GraphCollapse, maxNodes int) rippler.ReportPrinter {
+	return &mermaidPrinter{
+		collapse: collapse,
+		maxNodes: maxNodes,
+	}
+}
+
+// Print prints the affected graph as a fenced Mermaid code block, ready to be pasted into
+// a pull request description.
+func (m *mermaidPrinter) Print(w io.Writer, report *rippler.Report) error {
+	if _, err := fmt.Fprintf(w, "```mermaid\n%s```\n", mermaidGraph(report, m.collapse, m.maxNodes)); err != nil {
+		return fmt.Errorf("failed to write mermaid graph: %w", err)
+	}
+
+	return nil
+}
+
+// mermaidGraph renders the affected graph of the report as a Mermaid flowchart.
+func mermaidGraph(report *rippler.Report, collapse GraphCollapse, maxNodes int) string {
+	nodes, edges := collapsedGraph(report, collapse)
+	nodes, edges = capGraph(report, nodes, edges, maxNodes)
+
+	ids := make(map[string]string, len(nodes))
+	for i := range nodes {
+		ids[nodes[i].ID] = fmt.Sprintf("n%d", i)
+	}
+
+	b := strings.Builder{}
+	b.WriteString("graph LR\n")
+
+	for _, node := range nodes {
+		label := node.Label
+		if node.Size > 1 && node.Kind != nodeSummary {
+			label = fmt.Sprintf("%s (%d packages)", label, node.Size)
+		}
+
+		fmt.Fprintf(&b, "  %s[\"%s\"]%s\n", ids[node.ID], strings.ReplaceAll(label, `"`, "#quot;"), mermaidClass(node.Kind))
+	}
+
+	for _, edge := range edges {
+		arrow := "-->"
+		if edge.Test {
+			arrow = "-.->|test|"
+		}
+
+		fmt.Fprintf(&b, "  %s %s %s\n", ids[edge.From], arrow, ids[edge.To])
+	}
+
+	b.WriteString("  classDef direct fill:#c8e6c9,stroke:#2e7d32,font-weight:bold\n")
+	b.WriteString("  classDef external fill:#eeeeee,stroke:#616161,stroke-dasharray:5 5\n")
+	b.WriteString("  classDef summary fill:#ffffff,stroke:#9e9e9e,stroke-dasharray:3 3\n")
+
+	return b.String()
+}
+
+func mermaidClass(kind nodeKind) string {
+	switch kind {
+	case nodeDirect:
+		return ":::direct"
+	case nodeExternal:
+		return ":::external"
+	case nodeSummary:
+		return ":::summary"
+	default:
+		return ""
+	}
+}
+
+// capGraph keeps at most maxNodes nodes of the graph, summary nodes included: the ones closest
+// to the changes first, the others being replaced with one summary node per top-level
+// directory, such as "+42 more in services/...". When there are more directories to summarize
+// than maxNodes, the others are summarized by a single "+42 more" node instead. A maxNodes of
+// zero or less means no limit.
+func capGraph(report *rippler.Report, nodes []graphNode, edges []graphEdge, maxNodes int) ([]graphNode, []graphEdge) {
+	if maxNodes <= 0 || len(nodes) <= maxNodes {
+		return nodes, edges
+	}
+
+	distance := graphDistances(nodes, edges)
+	ordered := slices.Clone(nodes)
+
+	slices.SortStableFunc(ordered, func(a, b graphNode) int {
+		return distance[a.ID] - distance[b.ID]
+	})
+
+	summaryDir := func(node graphNode) string {
+		return topLevelDir(report, node.ID)
+	}
+
+	keep := maxNodes - 1
+	for keep > 0 && keep+countSummaries(ordered[keep:], summaryDir) > maxNodes {
+		keep--
+	}
+
+	if keep+countSummaries(ordered[keep:], summaryDir) > maxNodes {
+		keep = maxNodes - 1
+		summaryDir = func(graphNode) string { return "" }
+	}
+
+	kept := make(map[string]struct{}, keep)
+	for _, node := range ordered[:keep] {
+		kept[node.ID] = struct{}{}
+	}
+
+	out := make([]graphNode, 0, maxNodes)
+	summaries := make(map[string]graphNode)
+	summaryOf := make(map[string]string)
+
+	for _, node := range nodes {
+		if _, ok := kept[node.ID]; ok {
+			out = append(out, node)
+
+			continue
+		}
+
+		dir := summaryDir(node)
+		id := "more:" + dir
+		summaryOf[node.ID] = id
+
+		summary := summaries[id]
+		summary.ID = id
+		summary.Kind = nodeSummary
+		summary.Size += node.Size
+		summary.Label = fmt.Sprintf("+%d more in %s/...", summary.Size, dir)
+
+		if dir == "" {
+			summary.Label = fmt.Sprintf("+%d more", summary.Size)
+		}
+
+		summaries[id] = summary
+	}
+
+	out = append(out, sortedNodes(summaries)...)
+
+	seen := make(map[graphEdge]struct{})
+	outEdges := make([]graphEdge, 0)
+
+	for _, edge := range edges {
+		_, fromKept := kept[edge.From]
+		_, toKept := kept[edge.To]
+
+		switch {
+		case fromKept && toKept:
+			outEdges = append(outEdges, edge)
+		case fromKept:
+			summarized := graphEdge{From: edge.From, To: summaryOf[edge.To]}
+			if _, ok := seen[summarized]; !ok {
+				seen[summarized] = struct{}{}
+				outEdges = append(outEdges, summarized)
+			}
+		}
+	}
+
+	return out, outEdges
+}
+
+// countSummaries returns the number of summary nodes the given nodes are replaced with.
+func countSummaries(nodes []graphNode, summaryDir func(graphNode) string) int {
+	dirs := make(map[string]struct{})
+	for _, node := range nodes {
+		dirs[summaryDir(node)] = struct{}{}
+	}
+
+	return len(dirs)
+}
+
+// graphDistances computes, for every node, the number of hops from the nearest changed
+// node (direct change or external module). Unreachable nodes get the node count.
+func graphDistances(nodes []graphNode, edges []graphEdge) map[string]int {
+	next := make(map[string][]string)
+	for _, edge := range edges {
+		next[edge.From] = append(next[edge.From], edge.To)
+	}
+
+	distance := make(map[string]int, len(nodes))
+	queue := make([]string, 0)
+
+	for _, node := range nodes {
+		distance[node.ID] = len(nodes)
+
+		if node.Kind == nodeDirect || node.Kind == nodeExternal {
+			distance[node.ID] = 0
+			queue = append(queue, node.ID)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, dep := range next[current] {
+			if distance[dep] > distance[current]+1 {
+				distance[dep] = distance[current] + 1
+				queue = append(queue, dep)
+			}
+		}
+	}
+
+	return distance
+}
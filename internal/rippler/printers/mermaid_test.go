@@ -0,0 +1,81 @@
+package printers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestCapGraph(t *testing.T) {
+	const module = "example.com/project"
+
+	report := &rippler.Report{GoMod: model.GoMod{Module: model.GoModDependency{Path: module}}}
+
+	nodes := []graphNode{{ID: module + "/core", Kind: nodeDirect, Size: 1}}
+	edges := make([]graphEdge, 0)
+
+	for _, dir := range []string{"api", "jobs", "services", "tools"} {
+		for i := range 3 {
+			pkg := fmt.Sprintf("%s/%s/p%d", module, dir, i)
+			nodes = append(nodes, graphNode{ID: pkg, Kind: nodePropagated, Size: 1})
+			edges = append(edges, graphEdge{From: module + "/core", To: pkg})
+		}
+	}
+
+	for maxNodes := range len(nodes) + 2 {
+		t.Run(fmt.Sprintf("max %d", maxNodes), func(t *testing.T) {
+			gotNodes, gotEdges := capGraph(report, nodes, edges, maxNodes)
+
+			if maxNodes > 0 && len(gotNodes) > maxNodes {
+				t.Errorf("capGraph() kept %d nodes, want at most %d", len(gotNodes), maxNodes)
+			}
+
+			ids := make(map[string]struct{})
+			size := 0
+
+			for _, node := range gotNodes {
+				ids[node.ID] = struct{}{}
+				size += node.Size
+			}
+
+			if size != len(nodes) {
+				t.Errorf("capGraph() nodes stand for %d packages, want %d", size, len(nodes))
+			}
+
+			for _, edge := range gotEdges {
+				_, fromOK := ids[edge.From]
+				_, toOK := ids[edge.To]
+
+				if !fromOK || !toOK {
+					t.Errorf("capGraph() edge %v links unknown nodes", edge)
+				}
+			}
+		})
+	}
+
+	t.Run("summaries", func(t *testing.T) {
+		gotNodes, _ := capGraph(report, nodes, edges, 5)
+
+		labels := make([]string, 0)
+		for _, node := range gotNodes {
+			if node.Kind == nodeSummary {
+				labels = append(labels, node.Label)
+			}
+		}
+
+		want := []string{"+3 more in api/...", "+3 more in jobs/...", "+3 more in services/...", "+3 more in tools/..."}
+		if fmt.Sprint(labels) != fmt.Sprint(want) {
+			t.Errorf("capGraph() summaries = %q, want %q", labels, want)
+		}
+	})
+
+	t.Run("single summary", func(t *testing.T) {
+		gotNodes, _ := capGraph(report, nodes, edges, 3)
+
+		if last := gotNodes[len(gotNodes)-1]; last.Label != "+11 more" {
+			t.Errorf("capGraph() summary = %q, want %q", last.Label, "+11 more")
+		}
+	})
+}
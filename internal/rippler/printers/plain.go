@@ -2,6 +2,7 @@ package printers
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/tangelo-labs/go-ripple/internal/rippler"
 )
@@ -14,9 +15,11 @@ func NewPlainPrinter() rippler.ReportPrinter {
 }
 
 // Print prints the import paths of all affected packages, one per line.
-func (p *plainPrinter) Print(report *rippler.Report) error {
+func (p *plainPrinter) Print(w io.Writer, report *rippler.Report) error {
 	for i := range report.AffectedPackages {
-		fmt.Println(report.AffectedPackages[i].ImportPath)
+		if _, err := fmt.Fprintln(w, report.AffectedPackages[i].ImportPath); err != nil {
+			return fmt.Errorf("failed to write affected packages: %w", err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,206 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+// ReportSchemaVersion is the version of the JSON document produced by the report-json printer.
+// It is bumped on every backward-incompatible change of the document structure, as described
+// by the JSON Schema printed by `go-ripple schema`. New optional fields and new enumeration
+// values, such as reason kinds, are additive and do not bump it.
+const ReportSchemaVersion = 2
+
+type reportJSONPrinter struct{}
+
+type reportDocument struct {
+	SchemaVersion    int                   `json:"schemaVersion"`
+	Module           string                `json:"module"`
+	Base             reportRevision        `json:"base"`
+	Head             reportRevision        `json:"head"`
+	ChangedFiles     []string              `json:"changedFiles"`
+	DirtyFiles       []string              `json:"dirtyFiles"`
+	IgnoredFiles     []reportIgnoredFile   `json:"ignoredFiles"`
+	Changes          []reportChange        `json:"changes"`
+	ModuleChanges    []reportModuleChange  `json:"moduleChanges"`
+	AffectedPackages []reportPackage       `json:"affectedPackages"`
+	Applications     []testPlanApplication `json:"applications"`
+	Binaries         []binary              `json:"binaries"`
+	Artifacts        []artifact            `json:"artifacts"`
+}
+
+type reportRevision struct {
+	Ref      string `json:"ref,omitempty"`
+	Revision string `json:"revision"`
+}
+
+type reportIgnoredFile struct {
+	Path string `json:"path"`
+	Rule string `json:"rule"`
+}
+
+type reportChange struct {
+	Package string         `json:"package"`
+	Reasons []reportReason `json:"reasons"`
+}
+
+type reportReason struct {
+	Kind       string `json:"kind"`
+	Message    string `json:"message"`
+	File       string `json:"file,omitempty"`
+	Module     string `json:"module,omitempty"`
+	OldVersion string `json:"oldVersion,omitempty"`
+	NewVersion string `json:"newVersion,omitempty"`
+	Rule       string `json:"rule,omitempty"`
+}
+
+type reportModuleChange struct {
+	Path       string `json:"path"`
+	OldVersion string `json:"oldVersion,omitempty"`
+	NewVersion string `json:"newVersion,omitempty"`
+	Indirect   bool   `json:"indirect"`
+}
+
+type reportPackage struct {
+	ImportPath string   `json:"importPath"`
+	Indirect   bool     `json:"indirect"`
+	Distance   int      `json:"distance"`
+	Parents    []string `json:"parents,omitempty"`
+	Origin     string   `json:"origin"`
+}
+
+// NewReportJSONPrinter creates a new instance of the report JSON printer, which serializes the
+// whole report as a versioned JSON document meant for machine consumers.
+func NewReportJSONPrinter() rippler.ReportPrinter {
+	return &reportJSONPrinter{}
+}
+
+// Print prints the whole report in JSON format. File paths are relative to the repository root,
+// and every list is sorted, so the output is stable across machines and runs.
+func (j *reportJSONPrinter) Print(w io.Writer, report *rippler.Report) error {
+	jsonData, err := json.MarshalIndent(reportJSON(report), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(w, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to write JSON report: %w", err)
+	}
+
+	return nil
+}
+
+// reportJSON builds the JSON document for the given report.
+func reportJSON(report *rippler.Report) reportDocument {
+	doc := reportDocument{
+		SchemaVersion:    ReportSchemaVersion,
+		Module:           report.GoMod.Module.Path,
+		Base:             reportRevision{Ref: report.BaseRef, Revision: report.BaseRevision},
+		Head:             reportRevision{Ref: "HEAD", Revision: report.HeadRevision},
+		ChangedFiles:     relativePaths(report, report.ChangedFiles),
+		DirtyFiles:       relativePaths(report, report.DirtyFiles),
+		IgnoredFiles:     make([]reportIgnoredFile, 0, len(report.IgnoredFiles)),
+		Changes:          make([]reportChange, 0, len(report.Changes)),
+		ModuleChanges:    make([]reportModuleChange, 0, len(report.ModuleChanges)),
+		AffectedPackages: make([]reportPackage, 0, len(report.AffectedPackages)),
+		Applications:     make([]testPlanApplication, 0, len(report.Applications)),
+		Binaries:         make([]binary, 0, len(report.Binaries)),
+		Artifacts:        make([]artifact, 0, len(report.Artifacts)),
+	}
+
+	for _, ignored := range report.IgnoredFiles {
+		doc.IgnoredFiles = append(doc.IgnoredFiles, reportIgnoredFile{
+			Path: relativePath(report, ignored.Path),
+			Rule: ignored.Rule,
+		})
+	}
+
+	for _, change := range report.Changes {
+		reasons := make([]reportReason, 0, len(change.Reasons))
+		for _, reason := range change.Reasons {
+			reasons = append(reasons, newReportReason(report, reason))
+		}
+
+		doc.Changes = append(doc.Changes, reportChange{Package: change.PackageName, Reasons: reasons})
+	}
+
+	for _, mod := range report.ModuleChanges {
+		doc.ModuleChanges = append(doc.ModuleChanges, reportModuleChange(mod))
+	}
+
+	for _, pkg := range report.AffectedPackages {
+		doc.AffectedPackages = append(doc.AffectedPackages, reportPackage(pkg))
+	}
+
+	for _, app := range report.Applications {
+		doc.Applications = append(doc.Applications, testPlanApplication{
+			Name:     app.Name,
+			Roots:    app.Roots,
+			Packages: app.AffectedPackages,
+		})
+	}
+
+	for _, bin := range report.Binaries {
+		doc.Binaries = append(doc.Binaries, newBinary(report, bin))
+	}
+
+	for _, art := range report.Artifacts {
+		doc.Artifacts = append(doc.Artifacts, artifact{Name: art.Name, Kind: art.Kind, Chain: art.Chain, Files: relativePaths(report, art.Files)})
+	}
+
+	slices.SortFunc(doc.IgnoredFiles, func(a, b reportIgnoredFile) int { return strings.Compare(a.Path, b.Path) })
+	slices.SortFunc(doc.Changes, func(a, b reportChange) int { return strings.Compare(a.Package, b.Package) })
+	slices.SortFunc(doc.ModuleChanges, func(a, b reportModuleChange) int { return strings.Compare(a.Path, b.Path) })
+	slices.SortFunc(doc.AffectedPackages, func(a, b reportPackage) int { return strings.Compare(a.ImportPath, b.ImportPath) })
+	slices.SortFunc(doc.Applications, func(a, b testPlanApplication) int { return strings.Compare(a.Name, b.Name) })
+	slices.SortFunc(doc.Artifacts, func(a, b artifact) int { return strings.Compare(a.Name, b.Name) })
+
+	return doc
+}
+
+// newReportReason converts a change reason, making its file relative to the repository root.
+func newReportReason(report *rippler.Report, reason rippler.Reason) reportReason {
+	out := reportReason{
+		Kind:       string(reason.Kind),
+		Message:    relativeReason(report, reason),
+		Module:     reason.Module,
+		OldVersion: reason.OldVersion,
+		NewVersion: reason.NewVersion,
+		Rule:       reason.Rule,
+	}
+
+	if reason.File != "" {
+		out.File = relativePath(report, reason.File)
+	}
+
+	return out
+}
+
+// relativePaths returns the given absolute paths relative to the repository root, sorted.
+func relativePaths(report *rippler.Report, paths []string) []string {
+	out := make([]string, 0, len(paths))
+	for i := range paths {
+		out = append(out, relativePath(report, paths[i]))
+	}
+
+	slices.Sort(out)
+
+	return out
+}
+
+// relativePath returns the given absolute path relative to the repository root, using forward
+// slashes. Paths outside of the repository are returned unchanged.
+func relativePath(report *rippler.Report, path string) string {
+	rel, err := filepath.Rel(report.RepositoryDir, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+
+	return filepath.ToSlash(rel)
+}
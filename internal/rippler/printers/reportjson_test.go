@@ -0,0 +1,114 @@
+package printers
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestReportJSON(t *testing.T) {
+	const module = "example.com/project"
+
+	report := &rippler.Report{
+		GoMod:         model.GoMod{Module: model.GoModDependency{Path: module}},
+		BaseRef:       "origin/main",
+		BaseRevision:  "abc123",
+		HeadRevision:  "def456",
+		RepositoryDir: "/repo",
+		ModuleDir:     "/repo",
+		ChangedFiles:  []string{"/repo/users/user.go", "/repo/go.mod", "/repo/docs/guide.md"},
+		DirtyFiles:    []string{"/repo/users/user.go"},
+		IgnoredFiles:  []rippler.IgnoredFile{{Path: "/repo/docs/guide.md", Rule: "docs/**"}},
+		Changes: []rippler.Change{
+			{
+				PackageName: module + "/users",
+				Reasons: []rippler.Reason{
+					{Kind: rippler.ReasonFile, File: "/repo/users/user.go"},
+					{Kind: rippler.ReasonTestFile, File: "/repo/users/user_test.go"},
+				},
+			},
+			{
+				PackageName: "github.com/lib/pq",
+				Reasons: []rippler.Reason{{
+					Kind:       rippler.ReasonGoModRequire,
+					File:       "/repo/go.mod",
+					Module:     "github.com/lib/pq",
+					OldVersion: "v1.10.0",
+					NewVersion: "v1.10.9",
+				}},
+			},
+		},
+		AffectedPackages: []model.AffectedPackage{
+			{ImportPath: module + "/users", Origin: module + "/users"},
+			{ImportPath: module + "/api", Distance: 1, Parents: []string{module + "/users"}, Origin: module + "/users"},
+		},
+	}
+
+	doc := reportJSON(report)
+
+	if doc.SchemaVersion != ReportSchemaVersion || doc.Module != module {
+		t.Errorf("reportJSON() schema version and module = %d, %s, want %d, %s", doc.SchemaVersion, doc.Module, ReportSchemaVersion, module)
+	}
+
+	wantRevisions := [2]reportRevision{{Ref: "origin/main", Revision: "abc123"}, {Ref: "HEAD", Revision: "def456"}}
+	if got := [2]reportRevision{doc.Base, doc.Head}; got != wantRevisions {
+		t.Errorf("reportJSON() revisions = %+v, want %+v", got, wantRevisions)
+	}
+
+	if want := []string{"docs/guide.md", "go.mod", "users/user.go"}; !reflect.DeepEqual(doc.ChangedFiles, want) {
+		t.Errorf("reportJSON() changed files = %v, want %v", doc.ChangedFiles, want)
+	}
+
+	if want := []reportIgnoredFile{{Path: "docs/guide.md", Rule: "docs/**"}}; !reflect.DeepEqual(doc.IgnoredFiles, want) {
+		t.Errorf("reportJSON() ignored files = %+v, want %+v", doc.IgnoredFiles, want)
+	}
+
+	wantChanges := []reportChange{
+		{
+			Package: module + "/users",
+			Reasons: []reportReason{
+				{Kind: "file", Message: "file users/user.go has changed", File: "users/user.go"},
+				{Kind: "test-file", Message: "test file users/user_test.go has changed", File: "users/user_test.go"},
+			},
+		},
+		{
+			Package: "github.com/lib/pq",
+			Reasons: []reportReason{{
+				Kind:       "go.mod-require",
+				Message:    "module github.com/lib/pq has changed in go.mod (from v1.10.0 to v1.10.9)",
+				File:       "go.mod",
+				Module:     "github.com/lib/pq",
+				OldVersion: "v1.10.0",
+				NewVersion: "v1.10.9",
+			}},
+		},
+	}
+
+	if !reflect.DeepEqual(doc.Changes, wantChanges) {
+		t.Errorf("reportJSON() changes = %+v, want %+v", doc.Changes, wantChanges)
+	}
+
+	wantPackages := []reportPackage{
+		{ImportPath: module + "/api", Distance: 1, Parents: []string{module + "/users"}, Origin: module + "/users"},
+		{ImportPath: module + "/users", Origin: module + "/users"},
+	}
+
+	if !reflect.DeepEqual(doc.AffectedPackages, wantPackages) {
+		t.Errorf("reportJSON() affected packages = %+v, want %+v", doc.AffectedPackages, wantPackages)
+	}
+
+	// Empty lists are serialized as such, not as null.
+	for name, list := range map[string]any{
+		"moduleChanges": doc.ModuleChanges,
+		"applications":  doc.Applications,
+		"binaries":      doc.Binaries,
+		"artifacts":     doc.Artifacts,
+	} {
+		if data, err := json.Marshal(list); err != nil || string(data) != "[]" {
+			t.Errorf("reportJSON() %s = %s, want []", name, data)
+		}
+	}
+}
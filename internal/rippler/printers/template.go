@@ -0,0 +1,138 @@
+package printers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+type templatePrinter struct {
+	text string
+}
+
+// NewTemplatePrinter creates a new instance of the template printer, which renders the whole
+// report through the given text/template. Besides the builtin ones, templates can use:
+//
+//   - relDir PKG: the directory of a project package relative to the module root, e.g. "./users";
+//   - join SEP LIST: the strings of LIST separated by SEP;
+//   - importPaths PKGS: the import paths of a list of affected packages;
+//   - moduleOf PKG: the path of the module providing a package;
+//   - isMain PKG: whether a project package is a main package;
+//   - toJSON VALUE: the JSON encoding of any value.
+//
+// For example: {{ importPaths .AffectedPackages | join "," }}.
+func NewTemplatePrinter(text string) (rippler.ReportPrinter, error) {
+	// Parse once with placeholder functions to report syntax errors before the analysis runs.
+	if _, err := template.New("report").Funcs(templateFuncs(&rippler.Report{})).Parse(text); err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	return &templatePrinter{text: text}, nil
+}
+
+// Print renders the report through the template.
+func (t *templatePrinter) Print(w io.Writer, report *rippler.Report) error {
+	tpl, err := template.New("report").Funcs(templateFuncs(report)).Parse(t.text)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	out := bytes.Buffer{}
+	if eErr := tpl.Execute(&out, report); eErr != nil {
+		return fmt.Errorf("failed to render template: %w", eErr)
+	}
+
+	if _, wErr := out.WriteTo(w); wErr != nil {
+		return fmt.Errorf("failed to write rendered template: %w", wErr)
+	}
+
+	return nil
+}
+
+// templateFuncs returns the helper functions available to templates rendering the given report.
+func templateFuncs(report *rippler.Report) template.FuncMap {
+	packages := make(map[string]model.Package, len(report.AllPackages))
+	for i := range report.AllPackages {
+		packages[report.AllPackages[i].ImportPath] = report.AllPackages[i]
+	}
+
+	return template.FuncMap{
+		"relDir": func(pkg string) string {
+			p, ok := packages[pkg]
+			if !ok {
+				return pkg
+			}
+
+			return moduleRelative(report, p.Dir)
+		},
+		"join": func(sep string, items []string) string {
+			return strings.Join(items, sep)
+		},
+		"importPaths": func(pkgs []model.AffectedPackage) []string {
+			out := make([]string, 0, len(pkgs))
+			for i := range pkgs {
+				out = append(out, pkgs[i].ImportPath)
+			}
+
+			return out
+		},
+		"moduleOf": func(pkg string) string {
+			return moduleOf(report, pkg)
+		},
+		"isMain": func(pkg string) bool {
+			return packages[pkg].Name == "main"
+		},
+		"toJSON": func(v any) (string, error) {
+			data, err := json.Marshal(v)
+			if err != nil {
+				return "", fmt.Errorf("failed to marshal JSON: %w", err)
+			}
+
+			return string(data), nil
+		},
+	}
+}
+
+// moduleRelative returns the given directory relative to the module root, e.g. "./users".
+func moduleRelative(report *rippler.Report, dir string) string {
+	rel, err := filepath.Rel(report.ModuleDir, dir)
+	if err != nil {
+		return dir
+	}
+
+	if rel == "." {
+		return "."
+	}
+
+	return "./" + filepath.ToSlash(rel)
+}
+
+// moduleOf returns the path of the module providing the given package: the analyzed module,
+// or the required module with the longest matching path. It is empty for unknown packages,
+// such as standard library ones.
+func moduleOf(report *rippler.Report, pkg string) string {
+	within := func(modulePath string) bool {
+		return modulePath != "" && (pkg == modulePath || strings.HasPrefix(pkg, modulePath+"/"))
+	}
+
+	best := ""
+
+	if within(report.GoMod.Module.Path) {
+		best = report.GoMod.Module.Path
+	}
+
+	for i := range report.GoMod.Require {
+		if path := report.GoMod.Require[i].Path; within(path) && len(path) > len(best) {
+			best = path
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,86 @@
+package printers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestTemplatePrinter(t *testing.T) {
+	const module = "example.com/project"
+
+	report := &rippler.Report{
+		GoMod: model.GoMod{
+			Module:  model.GoModDependency{Path: module},
+			Require: []model.GoModDependency{{Path: "github.com/aws/aws-sdk-go-v2"}, {Path: "github.com/aws/aws-sdk-go-v2/service/s3"}},
+		},
+		ModuleDir: "/repo",
+		AllPackages: []model.Package{
+			{ImportPath: module + "/cmd/api", Dir: "/repo/cmd/api", Name: "main"},
+			{ImportPath: module + "/users", Dir: "/repo/users", Name: "users"},
+		},
+		AffectedPackages: []model.AffectedPackage{
+			{ImportPath: module + "/cmd/api"},
+			{ImportPath: module + "/users"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "import paths",
+			text: `{{ importPaths .AffectedPackages | join "," }}`,
+			want: "example.com/project/cmd/api,example.com/project/users",
+		},
+		{
+			name: "directories and main packages",
+			text: `{{ range .AffectedPackages }}{{ relDir .ImportPath }}{{ if isMain .ImportPath }}*{{ end }} {{ end }}`,
+			want: "./cmd/api* ./users ",
+		},
+		{
+			name: "modules",
+			text: `{{ moduleOf "example.com/project/users" }} {{ moduleOf "github.com/aws/aws-sdk-go-v2/service/s3/types" }} {{ moduleOf "fmt" }}.`,
+			want: "example.com/project github.com/aws/aws-sdk-go-v2/service/s3 .",
+		},
+		{
+			name: "JSON",
+			text: `{{ toJSON (importPaths .AffectedPackages) }}`,
+			want: `["example.com/project/cmd/api","example.com/project/users"]`,
+		},
+		{
+			name:    "execution error",
+			text:    `{{ .Unknown }}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			printer, err := NewTemplatePrinter(tt.text)
+			if err != nil {
+				t.Fatalf("NewTemplatePrinter() error = %v", err)
+			}
+
+			var out bytes.Buffer
+
+			pErr := printer.Print(&out, report)
+			if (pErr != nil) != tt.wantErr {
+				t.Fatalf("Print() error = %v, wantErr %v", pErr, tt.wantErr)
+			}
+
+			if got := out.String(); !tt.wantErr && got != tt.want {
+				t.Errorf("Print() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewTemplatePrinter(`{{ .AffectedPackages `); err == nil {
+		t.Error("NewTemplatePrinter() error = nil for a malformed template")
+	}
+}
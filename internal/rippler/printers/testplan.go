@@ -0,0 +1,81 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+type testPlanPrinter struct{}
+
+// testPlan is the JSON document produced by the test-plan printer.
+type testPlan struct {
+	// Applications lists the affected applications and the affected packages they depend on.
+	Applications []testPlanApplication `json:"applications"`
+
+	// Shared lists the affected packages that no application depends on.
+	Shared []string `json:"shared"`
+}
+
+type testPlanApplication struct {
+	Name     string   `json:"name"`
+	Roots    []string `json:"roots"`
+	Packages []string `json:"packages"`
+}
+
+// NewTestPlanPrinter creates a new instance of the test plan printer, which groups affected
+// packages by the applications depending on them.
+func NewTestPlanPrinter() rippler.ReportPrinter {
+	return &testPlanPrinter{}
+}
+
+// Print prints the affected packages grouped by application in JSON format.
+func (t *testPlanPrinter) Print(w io.Writer, report *rippler.Report) error {
+	plan := testPlan{
+		Applications: make([]testPlanApplication, 0, len(report.Applications)),
+		Shared:       sharedPackages(report),
+	}
+
+	for i := range report.Applications {
+		plan.Applications = append(plan.Applications, testPlanApplication{
+			Name:     report.Applications[i].Name,
+			Roots:    report.Applications[i].Roots,
+			Packages: report.Applications[i].AffectedPackages,
+		})
+	}
+
+	jsonData, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(w, string(jsonData)); err != nil {
+		return fmt.Errorf("failed to write test plan: %w", err)
+	}
+
+	return nil
+}
+
+// sharedPackages returns the import paths of the affected packages that do not belong
+// to any affected application, in the report order.
+func sharedPackages(report *rippler.Report) []string {
+	grouped := make(map[string]struct{})
+
+	for i := range report.Applications {
+		for _, pkg := range report.Applications[i].AffectedPackages {
+			grouped[pkg] = struct{}{}
+		}
+	}
+
+	out := make([]string, 0)
+
+	for i := range report.AffectedPackages {
+		if _, ok := grouped[report.AffectedPackages[i].ImportPath]; !ok {
+			out = append(out, report.AffectedPackages[i].ImportPath)
+		}
+	}
+
+	return out
+}
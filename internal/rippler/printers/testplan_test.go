@@ -0,0 +1,52 @@
+package printers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestTestPlanPrinter(t *testing.T) {
+	report := &rippler.Report{
+		AffectedPackages: []model.AffectedPackage{
+			{ImportPath: "example.com/project/cmd/api"},
+			{ImportPath: "example.com/project/internal/db"},
+			{ImportPath: "example.com/project/tools/gen"},
+		},
+		Applications: []rippler.Application{{
+			Name:             "api",
+			Roots:            []string{"example.com/project/cmd/api"},
+			AffectedPackages: []string{"example.com/project/cmd/api", "example.com/project/internal/db"},
+		}},
+	}
+
+	want := `{
+  "applications": [
+    {
+      "name": "api",
+      "roots": [
+        "example.com/project/cmd/api"
+      ],
+      "packages": [
+        "example.com/project/cmd/api",
+        "example.com/project/internal/db"
+      ]
+    }
+  ],
+  "shared": [
+    "example.com/project/tools/gen"
+  ]
+}
+`
+
+	var out bytes.Buffer
+	if err := NewTestPlanPrinter().Print(&out, report); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+
+	if got := out.String(); got != want {
+		t.Errorf("Print() =\n%s\nwant\n%s", got, want)
+	}
+}
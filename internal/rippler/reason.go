@@ -0,0 +1,95 @@
+package rippler
+
+import (
+	"fmt"
+)
+
+// ReasonKind tells which kind of change made a package be considered changed.
+type ReasonKind string
+
+const (
+	// ReasonFile is a change to one of the package's source files.
+	ReasonFile ReasonKind = "file"
+
+	// ReasonTestFile is a change to one of the package's test files. It is only reported
+	// along with other reasons, as test files alone do not make a package changed.
+	ReasonTestFile ReasonKind = "test-file"
+
+	// ReasonGoModRequire is an added or upgraded module requirement in go.mod.
+	ReasonGoModRequire ReasonKind = "go.mod-require"
+
+	// ReasonGoModReplace is an added, removed or updated replace directive in go.mod. It is
+	// only reported along with a requirement change of the same module.
+	ReasonGoModReplace ReasonKind = "go.mod-replace"
+
+	// ReasonGoSum is a change of the resolved version of a (usually indirect) module.
+	ReasonGoSum ReasonKind = "go.sum"
+
+	// ReasonRule is a changed file matching a configured file-to-package rule.
+	ReasonRule ReasonKind = "rule"
+
+	// ReasonGlobalTrigger is a changed file matching a configured global trigger.
+	ReasonGlobalTrigger ReasonKind = "global-trigger"
+
+	// ReasonRequested is a package given as changed to Rippler.Impact.
+	ReasonRequested ReasonKind = "requested"
+)
+
+// Reason explains why a package is considered changed. Which fields are set depends on Kind.
+type Reason struct {
+	// Kind is the kind of change.
+	Kind ReasonKind
+
+	// File is the absolute path of the changed file, if any.
+	File string
+
+	// Module is the path of the changed module, for go.mod and go.sum changes.
+	Module string
+
+	// OldVersion is the version (or replacement) in the base branch, empty when added.
+	OldVersion string
+
+	// NewVersion is the current version (or replacement), empty when removed.
+	NewVersion string
+
+	// Rule is the matching rule name or pattern, for rules and global triggers.
+	Rule string
+}
+
+// String returns a human-readable description of the reason.
+func (r Reason) String() string {
+	switch r.Kind {
+	case ReasonFile:
+		return fmt.Sprintf("file %s has changed", r.File)
+	case ReasonTestFile:
+		return fmt.Sprintf("test file %s has changed", r.File)
+	case ReasonGoModRequire:
+		return fmt.Sprintf("module %s has changed in go.mod%s", r.Module, versionDelta(r.OldVersion, r.NewVersion))
+	case ReasonGoModReplace:
+		return fmt.Sprintf("replacement of module %s has changed in go.mod%s", r.Module, versionDelta(r.OldVersion, r.NewVersion))
+	case ReasonGoSum:
+		return fmt.Sprintf("indirect module %s has changed in go.sum%s", r.Module, versionDelta(r.OldVersion, r.NewVersion))
+	case ReasonRule:
+		return fmt.Sprintf("file %s matches rule %q", r.File, r.Rule)
+	case ReasonGlobalTrigger:
+		return fmt.Sprintf("file %s matches global trigger %q", r.File, r.Rule)
+	case ReasonRequested:
+		return "package was given as changed"
+	default:
+		return fmt.Sprintf("%s change", r.Kind)
+	}
+}
+
+// versionDelta describes a version change, e.g. " (from v1.2.0 to v1.3.0)".
+func versionDelta(oldVersion, newVersion string) string {
+	switch {
+	case oldVersion == "" && newVersion == "":
+		return ""
+	case oldVersion == "":
+		return fmt.Sprintf(" (added %s)", newVersion)
+	case newVersion == "":
+		return fmt.Sprintf(" (removed %s)", oldVersion)
+	default:
+		return fmt.Sprintf(" (from %s to %s)", oldVersion, newVersion)
+	}
+}
@@ -18,6 +18,17 @@ import (
 type Rippler struct {
 	goModFilePath string
 	baseBranch    string
+	repoRoot      string
+	rules         []FileRule
+	triggers      []string
+	included      []string
+	excluded      []string
+	applications  []ApplicationRoot
+	artifacts     []ArtifactRule
+
+	ignorePatterns  []string
+	ignoreGenerated bool
+	maxDepth        int
 }
 
 // Report holds the results of the ripple detection process.
@@ -25,9 +36,33 @@ type Report struct {
 	// GoMod contains the parsed go.mod file.
 	GoMod model.GoMod
 
+	// RepositoryDir is the absolute path of the root directory of the Git repository.
+	RepositoryDir string
+
+	// ModuleDir is the absolute path of the directory holding the go.mod file.
+	ModuleDir string
+
+	// BaseRef is the base branch or commit the changes are compared against, as given.
+	BaseRef string
+
+	// BaseRevision is the commit hash BaseRef resolves to.
+	BaseRevision string
+
+	// HeadRevision is the commit hash of HEAD. Note that uncommitted changes in the working
+	// tree are part of the analysis too.
+	HeadRevision string
+
+	// ChangedFiles contains the absolute paths of all files that have changed compared to
+	// the base branch, Go or not.
+	ChangedFiles []string
+
 	// DirtyFiles contains the list of Go files that have changed compared to the base branch.
 	DirtyFiles []string
 
+	// IgnoredFiles contains the changed files that were dropped by an ignore rule, and are
+	// thus not part of ChangedFiles nor DirtyFiles.
+	IgnoredFiles []IgnoredFile
+
 	// AllPackages contains the list of all packages in the Go project.
 	AllPackages []model.Package
 
@@ -39,6 +74,19 @@ type Report struct {
 
 	// Changes contains the list of detected changes in the Go project.
 	Changes []Change
+
+	// ModuleChanges contains the dependency modules that were added, removed or upgraded
+	// compared to the base branch, sorted by module path.
+	ModuleChanges []ModuleChange
+
+	// Applications contains the applications depending on any of the affected packages.
+	Applications []Application
+
+	// Binaries contains the affected main packages, i.e. the executables to rebuild.
+	Binaries []Binary
+
+	// Artifacts contains the affected deployable artifacts, as configured.
+	Artifacts []Artifact
 }
 
 // AffectedPackage represents a package that is affected by changes.
@@ -53,7 +101,23 @@ type Change struct {
 
 	// Reasons is a list of reasons why this package is considered changed.
 	// It can include file changes, go.mod changes, or external module changes.
-	Reasons []string
+	Reasons []Reason
+}
+
+// ModuleChange represents a dependency module whose version changed compared to the base branch.
+type ModuleChange struct {
+	// Path is the module path, e.g. "github.com/me/dependency".
+	Path string
+
+	// OldVersion is the version required by the base branch, empty if the module was added.
+	OldVersion string
+
+	// NewVersion is the version currently required, empty if the module was removed.
+	NewVersion string
+
+	// Indirect indicates whether the change only shows in the resolved module graph
+	// (i.e. go.sum), as opposed to a requirement declared in go.mod.
+	Indirect bool
 }
 
 // NewRippler creates a new instance of Rippler with the specified base branch.
@@ -98,6 +162,7 @@ func (r *Rippler) Changes(ctx context.Context) (*Report, error) {
 	}
 
 	report.GoMod = mod
+	report.ModuleDir = filepath.Dir(r.goModFilePath)
 
 	allPackages, err := r.listAllPackages(ctx)
 	if err != nil {
@@ -106,15 +171,38 @@ func (r *Rippler) Changes(ctx context.Context) (*Report, error) {
 
 	report.AllPackages = allPackages
 
-	dirtyFiles, err := r.getChangedGoFiles(ctx)
+	if pErr := r.checkPackagePatterns(report); pErr != nil {
+		return nil, fmt.Errorf("invalid package patterns: %w", pErr)
+	}
+
+	repoRoot, err := RepositoryRoot(ctx, filepath.Dir(r.goModFilePath))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get changed Go files: %w", err)
+		return nil, fmt.Errorf("failed to find repository root: %w", err)
 	}
 
-	report.DirtyFiles = dirtyFiles
+	r.repoRoot = repoRoot
+	report.RepositoryDir = repoRoot
+	report.BaseRef = r.baseBranch
 
-	// Direct file changes are the primary source of ripple detection.
-	changes := r.affectedPackagesByFileChanges(report)
+	if report.BaseRevision, err = r.resolveRevision(ctx, r.baseBranch); err != nil {
+		return nil, fmt.Errorf("failed to resolve base revision: %w", err)
+	}
+
+	if report.HeadRevision, err = r.resolveRevision(ctx, "HEAD"); err != nil {
+		return nil, fmt.Errorf("failed to resolve head revision: %w", err)
+	}
+
+	changedFiles, err := r.getChangedFiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changed files: %w", err)
+	}
+
+	report.ChangedFiles, report.IgnoredFiles = r.filterIgnoredFiles(changedFiles)
+	report.DirtyFiles = goFiles(report.ChangedFiles)
+
+	// Dependency changes are looked for even when a global trigger fires, so they are still
+	// reported, e.g. in the summaries.
+	moduleChanges := make([]Change, 0)
 
 	{
 		affectedByModChange, aErr := r.affectedPackagesByGoModChange(ctx, report)
@@ -122,7 +210,7 @@ func (r *Rippler) Changes(ctx context.Context) (*Report, error) {
 			return nil, fmt.Errorf("failed to determine affected packages by go.mod change: %w", aErr)
 		}
 
-		changes = append(changes, affectedByModChange...)
+		moduleChanges = append(moduleChanges, affectedByModChange...)
 	}
 
 	{
@@ -131,11 +219,30 @@ func (r *Rippler) Changes(ctx context.Context) (*Report, error) {
 			return nil, fmt.Errorf("failed to determine affected packages by external module change: %w", aErr)
 		}
 
-		changes = append(changes, affectedByByExternalModChange...)
+		moduleChanges = append(moduleChanges, affectedByByExternalModChange...)
+	}
+
+	// Global triggers short-circuit the propagation, as every package is affected anyway.
+	if trigger, triggered := r.globalTriggerChange(report); triggered {
+		report.Changes = []Change{trigger}
+		report.AffectedPackages = r.applyPackageFilters(report, allPackagesAffected(report))
+		report.Applications = r.affectedApplications(report)
+		report.Binaries = r.affectedBinaries(report)
+		report.Artifacts = r.affectedArtifacts(report)
+
+		return report, nil
 	}
 
+	// Direct file changes are the primary source of ripple detection.
+	changes := r.affectedPackagesByFileChanges(report)
+	changes = append(changes, r.affectedPackagesByRules(report)...)
+	changes = append(changes, moduleChanges...)
+
 	report.Changes = unifyChanges(changes)
-	report.AffectedPackages = r.propagateAffectedPackages(report)
+	report.AffectedPackages = r.applyPackageFilters(report, r.propagateAffectedPackages(report))
+	report.Applications = r.affectedApplications(report)
+	report.Binaries = r.affectedBinaries(report)
+	report.Artifacts = r.affectedArtifacts(report)
 
 	return report, nil
 }
@@ -145,7 +252,7 @@ func (r *Rippler) parseGoMod(ctx context.Context, path string) (model.GoMod, err
 
 	out, err := cmd.Output()
 	if err != nil {
-		return model.GoMod{}, fmt.Errorf("failed to parse go.mod (%s): %w", path, err)
+		return model.GoMod{}, fmt.Errorf("failed to parse go.mod (%s): %w", path, &CommandError{Tool: ToolGo, Err: err})
 	}
 
 	var mod model.GoMod
@@ -156,31 +263,69 @@ func (r *Rippler) parseGoMod(ctx context.Context, path string) (model.GoMod, err
 	return mod, nil
 }
 
-func (r *Rippler) getChangedGoFiles(ctx context.Context) ([]string, error) {
+// RepositoryRoot returns the absolute path of the top-level directory of the Git
+// repository holding the given directory.
+func RepositoryRoot(ctx context.Context, dir string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--show-toplevel")
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git rev-parse failed: %w", &CommandError{Tool: ToolGit, Err: err})
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// resolveRevision returns the commit hash the given Git reference points to.
+func (r *Rippler) resolveRevision(ctx context.Context, ref string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", ref+"^{commit}")
+	cmd.Dir = r.repoRoot
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git rev-parse %s failed: %w", ref, &CommandError{Tool: ToolGit, Err: err})
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// getChangedFiles returns the absolute paths of all files that differ from the base branch.
+// Git reports paths relative to the repository root, regardless of the working directory.
+func (r *Rippler) getChangedFiles(ctx context.Context) ([]string, error) {
 	cmd := exec.CommandContext(ctx, "git", "diff", "--name-only", r.baseBranch)
+	cmd.Dir = r.repoRoot
 
 	out, err := cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("git diff failed: %w", err)
+		return nil, fmt.Errorf("git diff failed: %w", &CommandError{Tool: ToolGit, Err: err})
 	}
 
-	goFiles := make([]string, 0)
+	files := make([]string, 0)
 	outLines := strings.Split(string(out), "\n")
 
 	for i := range outLines {
-		if !strings.HasSuffix(outLines[i], ".go") {
+		if strings.TrimSpace(outLines[i]) == "" {
 			continue
 		}
 
-		abs, fpErr := filepath.Abs(outLines[i])
-		if fpErr != nil {
-			return nil, fmt.Errorf("failed to get absolute path for %s: %w", outLines[i], fpErr)
-		}
+		files = append(files, filepath.Join(r.repoRoot, filepath.FromSlash(outLines[i])))
+	}
+
+	return files, nil
+}
 
-		goFiles = append(goFiles, abs)
+// goFiles filters the given file paths, keeping only Go source files.
+func goFiles(files []string) []string {
+	out := make([]string, 0)
+
+	for i := range files {
+		if strings.HasSuffix(files[i], ".go") {
+			out = append(out, files[i])
+		}
 	}
 
-	return goFiles, nil
+	return out
 }
 
 func (r *Rippler) listAllPackages(ctx context.Context) ([]model.Package, error) {
@@ -190,7 +335,7 @@ func (r *Rippler) listAllPackages(ctx context.Context) ([]model.Package, error)
 	cmd.Stderr = os.Stderr
 
 	if err := cmd.Run(); err != nil {
-		return nil, fmt.Errorf("go list failed: %w", err)
+		return nil, fmt.Errorf("go list failed: %w", &CommandError{Tool: ToolGo, Err: err})
 	}
 
 	var packages []model.Package
@@ -210,46 +355,63 @@ func (r *Rippler) listAllPackages(ctx context.Context) ([]model.Package, error)
 }
 
 // affectedPackagesByFileChanges determines which packages are affected by the changes in dirty files.
+// Test files alone do not make a package changed, as they are not part of what its dependents
+// import, yet their changes are listed among the reasons of packages changed otherwise.
 func (r *Rippler) affectedPackagesByFileChanges(report *Report) []Change {
 	affected := make(map[string]Change)
 	pkgMap := r.mapPackagesByFile(report.AllPackages)
 
 	for i := range report.DirtyFiles {
-		if pkg, ok := pkgMap[report.DirtyFiles[i]]; ok {
-			if _, exists := affected[pkg]; !exists {
-				affected[pkg] = Change{
-					PackageName: pkg,
-					Reasons: []string{
-						fmt.Sprintf("file %s has changed", report.DirtyFiles[i]),
-					},
+		if file, ok := pkgMap[report.DirtyFiles[i]]; ok {
+			reason := Reason{Kind: file.kind, File: report.DirtyFiles[i]}
+
+			if _, exists := affected[file.pkg]; !exists {
+				affected[file.pkg] = Change{
+					PackageName: file.pkg,
+					Reasons:     []Reason{reason},
 				}
 			} else {
-				ch := affected[pkg]
-				ch.Reasons = append(ch.Reasons, fmt.Sprintf("file %s has changed", report.DirtyFiles[i]))
-				affected[pkg] = ch
+				ch := affected[file.pkg]
+				ch.Reasons = append(ch.Reasons, reason)
+				affected[file.pkg] = ch
 			}
 		}
 	}
 
 	out := make([]Change, 0)
+
 	for i := range affected {
-		out = append(out, affected[i])
+		if slices.ContainsFunc(affected[i].Reasons, func(reason Reason) bool { return reason.Kind != ReasonTestFile }) {
+			out = append(out, affected[i])
+		}
 	}
 
 	return out
 }
 
+// packageFile tells which package a file belongs to, and whether it is a test file.
+type packageFile struct {
+	pkg  string
+	kind ReasonKind
+}
+
 // mapPackagesByFile creates a mapping from absolute file paths to their corresponding package import paths.
-func (r *Rippler) mapPackagesByFile(pkgs []model.Package) map[string]string {
-	result := make(map[string]string)
+func (r *Rippler) mapPackagesByFile(pkgs []model.Package) map[string]packageFile {
+	result := make(map[string]packageFile)
 
-	for i := range pkgs {
-		for j := range pkgs[i].GoFiles {
-			fullPath := filepath.Join(pkgs[i].Dir, pkgs[i].GoFiles[j])
-			result[fullPath] = pkgs[i].ImportPath
+	add := func(pkg model.Package, files []string, kind ReasonKind) {
+		for j := range files {
+			fullPath := filepath.Join(pkg.Dir, files[j])
+			result[fullPath] = packageFile{pkg: pkg.ImportPath, kind: kind}
 		}
 	}
 
+	for i := range pkgs {
+		add(pkgs[i], pkgs[i].GoFiles, ReasonFile)
+		add(pkgs[i], pkgs[i].TestGoFiles, ReasonTestFile)
+		add(pkgs[i], pkgs[i].XTestGoFiles, ReasonTestFile)
+	}
+
 	return result
 }
 
@@ -272,17 +434,39 @@ func (r *Rippler) affectedPackagesByGoModChange(ctx context.Context, report *Rep
 		return nil, nil
 	}
 
-	changedMods, cmErr := r.getChangedModules(ctx, report.GoMod)
-	if cmErr != nil {
-		return nil, fmt.Errorf("failed to get changed modules: %w", cmErr)
+	oldMod, bmErr := r.getBaseGoMod(ctx)
+	if bmErr != nil {
+		return nil, fmt.Errorf("failed to get base go.mod: %w", bmErr)
 	}
 
-	for _, mod := range changedMods {
+	replacements := getChangedReplacements(report.GoMod, oldMod)
+
+	for _, mod := range getChangedModules(report.GoMod, oldMod) {
+		report.ModuleChanges = append(report.ModuleChanges, mod)
+
+		// Removed modules are no longer imported by any package.
+		if mod.NewVersion == "" {
+			continue
+		}
+
+		reasons := []Reason{{
+			Kind:       ReasonGoModRequire,
+			File:       r.goModFilePath,
+			Module:     mod.Path,
+			OldVersion: mod.OldVersion,
+			NewVersion: mod.NewVersion,
+		}}
+
+		// Replacements alone do not make a module changed, yet they explain the modules
+		// changed otherwise.
+		if reason, ok := replacements[mod.Path]; ok {
+			reason.File = r.goModFilePath
+			reasons = append(reasons, reason)
+		}
+
 		affected = append(affected, Change{
-			PackageName: mod,
-			Reasons: []string{
-				fmt.Sprintf("module %s has changed in go.mod", mod),
-			},
+			PackageName: mod.Path,
+			Reasons:     reasons,
 		})
 	}
 
@@ -298,7 +482,7 @@ func (r *Rippler) affectedPackagesByGoModChange(ctx context.Context, report *Rep
 // that has changed. In such cases, the indirect module may have been updated in the go.sum file, which can affect
 // the resolution of the indirect dependencies. This method collects all those indirect modules, so it can later
 // determine which packages depend on those modules and thus are affected by the change in go.sum.
-func (r *Rippler) affectedPackagesByExternalModule(ctx context.Context, _ *Report) ([]Change, error) {
+func (r *Rippler) affectedPackagesByExternalModule(ctx context.Context, report *Report) ([]Change, error) {
 	affected := make([]Change, 0)
 
 	indirectMods, err := r.getChangedIndirectModules(ctx)
@@ -306,15 +490,32 @@ func (r *Rippler) affectedPackagesByExternalModule(ctx context.Context, _ *Repor
 		return nil, fmt.Errorf("failed to get changed indirect modules: %w", err)
 	}
 
+	known := make(map[string]struct{})
+	for i := range report.ModuleChanges {
+		known[report.ModuleChanges[i].Path] = struct{}{}
+	}
+
 	for _, mod := range indirectMods {
+		if _, ok := known[mod.Path]; !ok {
+			report.ModuleChanges = append(report.ModuleChanges, mod)
+		}
+
 		affected = append(affected, Change{
-			PackageName: mod,
-			Reasons: []string{
-				fmt.Sprintf("indirect module %s has changed in go.sum", mod),
-			},
+			PackageName: mod.Path,
+			Reasons: []Reason{{
+				Kind:       ReasonGoSum,
+				File:       filepath.Join(filepath.Dir(r.goModFilePath), "go.sum"),
+				Module:     mod.Path,
+				OldVersion: mod.OldVersion,
+				NewVersion: mod.NewVersion,
+			}},
 		})
 	}
 
+	slices.SortFunc(report.ModuleChanges, func(a, b ModuleChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
 	return affected, nil
 }
 
@@ -323,48 +524,105 @@ func (r *Rippler) goModHasChanged(ctx context.Context) (bool, error) {
 
 	out, err := cmd.Output()
 	if err != nil {
-		return false, fmt.Errorf("git diff for go.mod failed: %w", err)
+		return false, fmt.Errorf("git diff for go.mod failed: %w", &CommandError{Tool: ToolGit, Err: err})
 	}
 
 	return strings.TrimSpace(string(out)) != "", nil
 }
 
-func (r *Rippler) getChangedModules(ctx context.Context, currentGoMod model.GoMod) ([]string, error) {
+// getBaseGoMod parses the go.mod file of the base branch.
+func (r *Rippler) getBaseGoMod(ctx context.Context) (model.GoMod, error) {
 	tmp := filepath.Join(os.TempDir(), "go.mod.base")
 	cmd := exec.CommandContext(ctx, "git", "show", r.baseBranch+":go.mod")
 
 	out, err := cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get base go.mod: %w", err)
+		return model.GoMod{}, fmt.Errorf("failed to get base go.mod: %w", &CommandError{Tool: ToolGit, Err: err})
 	}
 
 	if wfErr := os.WriteFile(tmp, out, 0644); wfErr != nil {
-		return nil, fmt.Errorf("failed to write temp go.mod: %w", wfErr)
+		return model.GoMod{}, fmt.Errorf("failed to write temp go.mod: %w", wfErr)
 	}
 
-	oldMod, err := r.parseGoMod(ctx, tmp)
-	if err != nil {
-		return nil, err
-	}
+	return r.parseGoMod(ctx, tmp)
+}
 
+// getChangedModules compares the requirements of both go.mod files.
+func getChangedModules(currentGoMod model.GoMod, oldMod model.GoMod) []ModuleChange {
 	oldSet := make(map[string]string)
 
 	for i := range oldMod.Require {
 		oldSet[oldMod.Require[i].Path] = oldMod.Require[i].Version
 	}
 
-	var changed []string
+	var changed []ModuleChange
+
+	newSet := make(map[string]struct{})
 
 	for i := range currentGoMod.Require {
+		newSet[currentGoMod.Require[i].Path] = struct{}{}
+
 		if oldVer, ok := oldSet[currentGoMod.Require[i].Path]; !ok || oldVer != currentGoMod.Require[i].Version {
-			changed = append(changed, currentGoMod.Require[i].Path)
+			changed = append(changed, ModuleChange{
+				Path:       currentGoMod.Require[i].Path,
+				OldVersion: oldVer,
+				NewVersion: currentGoMod.Require[i].Version,
+			})
 		}
 	}
 
-	return changed, nil
+	for i := range oldMod.Require {
+		if _, ok := newSet[oldMod.Require[i].Path]; !ok {
+			changed = append(changed, ModuleChange{
+				Path:       oldMod.Require[i].Path,
+				OldVersion: oldMod.Require[i].Version,
+			})
+		}
+	}
+
+	return changed
 }
 
-func (r *Rippler) getChangedIndirectModules(ctx context.Context) ([]string, error) {
+// getChangedReplacements compares the replace directives of both go.mod files, and returns
+// a reason for every replaced module whose replacement was added, removed or updated, keyed
+// by module path.
+func getChangedReplacements(currentGoMod model.GoMod, oldMod model.GoMod) map[string]Reason {
+	replacement := func(rep model.GoModReplace) string {
+		if rep.New.Version == "" {
+			return rep.New.Path
+		}
+
+		return rep.New.Path + "@" + rep.New.Version
+	}
+
+	oldSet := make(map[string]string)
+	for i := range oldMod.Replace {
+		oldSet[oldMod.Replace[i].Old.Path] = replacement(oldMod.Replace[i])
+	}
+
+	newSet := make(map[string]string)
+	for i := range currentGoMod.Replace {
+		newSet[currentGoMod.Replace[i].Old.Path] = replacement(currentGoMod.Replace[i])
+	}
+
+	changed := make(map[string]Reason)
+
+	for path, newRep := range newSet {
+		if oldRep := oldSet[path]; oldRep != newRep {
+			changed[path] = Reason{Kind: ReasonGoModReplace, Module: path, OldVersion: oldRep, NewVersion: newRep}
+		}
+	}
+
+	for path, oldRep := range oldSet {
+		if _, ok := newSet[path]; !ok {
+			changed[path] = Reason{Kind: ReasonGoModReplace, Module: path, OldVersion: oldRep}
+		}
+	}
+
+	return changed
+}
+
+func (r *Rippler) getChangedIndirectModules(ctx context.Context) ([]ModuleChange, error) {
 	baseMods, err := r.getBaseModules(ctx)
 	if err != nil {
 		return nil, err
@@ -375,12 +633,17 @@ func (r *Rippler) getChangedIndirectModules(ctx context.Context) ([]string, erro
 		return nil, err
 	}
 
-	var changed []string
+	var changed []ModuleChange
 
 	for path, newVer := range currentMods {
 		oldVer, exists := baseMods[path]
 		if !exists || oldVer != newVer {
-			changed = append(changed, path)
+			changed = append(changed, ModuleChange{
+				Path:       path,
+				OldVersion: oldVer,
+				NewVersion: newVer,
+				Indirect:   true,
+			})
 		}
 	}
 
@@ -392,7 +655,7 @@ func (r *Rippler) getAllModules(ctx context.Context) (map[string]string, error)
 
 	out, err := cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list current modules: %w", err)
+		return nil, fmt.Errorf("failed to list current modules: %w", &CommandError{Tool: ToolGo, Err: err})
 	}
 
 	modules := make(map[string]string)
@@ -416,7 +679,7 @@ func (r *Rippler) getBaseModules(ctx context.Context) (map[string]string, error)
 
 	out, err := cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get base go.mod: %w", err)
+		return nil, fmt.Errorf("failed to get base go.mod: %w", &CommandError{Tool: ToolGit, Err: err})
 	}
 
 	if wfErr := os.WriteFile(tmpMod, out, 0644); wfErr != nil {
@@ -436,7 +699,7 @@ func (r *Rippler) getBaseModules(ctx context.Context) (map[string]string, error)
 
 	out, err = cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list base modules: %w", err)
+		return nil, fmt.Errorf("failed to list base modules: %w", &CommandError{Tool: ToolGo, Err: err})
 	}
 
 	modules := make(map[string]string)
@@ -452,45 +715,69 @@ func (r *Rippler) getBaseModules(ctx context.Context) (map[string]string, error)
 	return modules, nil
 }
 
+// propagateAffectedPackages walks the reverse import graph breadth-first, from the directly
+// changed packages to every package depending on them, recording for each one its distance
+// to the nearest direct change, the packages pulling it in and the change it originates from.
+// Propagation stops after maxDepth hops, when set.
 func (r *Rippler) propagateAffectedPackages(report *Report) []model.AffectedPackage {
-	initial := report.Changes
-	dependents := make(map[string][]string)
-	initialMap := make(map[string]struct{})
+	affected := make(map[string]*model.AffectedPackage)
+	level := make([]string, 0)
+
+	seed := func(pkg, origin string) {
+		if _, ok := affected[pkg]; ok {
+			return
+		}
 
-	for i := range initial {
-		initialMap[initial[i].PackageName] = struct{}{}
+		affected[pkg] = &model.AffectedPackage{ImportPath: pkg, Origin: origin}
+		level = append(level, pkg)
 	}
 
-	for i := range report.AllPackages {
-		fullPackageImports := append(append(report.AllPackages[i].Imports, report.AllPackages[i].TestImports...), report.AllPackages[i].XTestImports...)
-		for j := range fullPackageImports {
-			dependents[fullPackageImports[j]] = append(dependents[fullPackageImports[j]], report.AllPackages[i].ImportPath)
+	for i := range report.Changes {
+		// Whole-module changes, such as global triggers, seed every matching package.
+		if strings.HasSuffix(report.Changes[i].PackageName, "/...") {
+			for _, pkg := range r.expandPackagePatterns(report, []string{report.Changes[i].PackageName}) {
+				seed(pkg, report.Changes[i].PackageName)
+			}
+
+			continue
 		}
-	}
 
-	queue := make([]string, 0)
-	for pkg := range initialMap {
-		queue = append(queue, pkg)
+		seed(report.Changes[i].PackageName, report.Changes[i].PackageName)
 	}
 
-	for len(queue) > 0 {
-		current := queue[0]
-		queue = queue[1:]
+	edges := dependentEdges(report.AllPackages, true)
+
+	for distance := 1; len(level) > 0 && (r.maxDepth <= 0 || distance <= r.maxDepth); distance++ {
+		slices.Sort(level)
+
+		next := make([]string, 0)
+
+		for _, current := range level {
+			for _, edge := range edges[current] {
+				pkg, ok := affected[edge.Importer]
 
-		for _, dep := range dependents[current] {
-			if _, ok := initialMap[dep]; !ok {
-				initialMap[dep] = struct{}{}
-				queue = append(queue, dep)
+				switch {
+				case !ok:
+					affected[edge.Importer] = &model.AffectedPackage{
+						ImportPath: edge.Importer,
+						Distance:   distance,
+						Parents:    []string{current},
+						Origin:     affected[current].Origin,
+					}
+					next = append(next, edge.Importer)
+				case pkg.Distance == distance && !slices.Contains(pkg.Parents, current):
+					pkg.Parents = append(pkg.Parents, current)
+				}
 			}
 		}
+
+		level = next
 	}
 
-	out := make([]model.AffectedPackage, 0)
-	for pkg := range initialMap {
-		out = append(out, model.AffectedPackage{
-			ImportPath: pkg,
-			Indirect:   !strings.HasPrefix(pkg, report.GoMod.Module.Path),
-		})
+	out := make([]model.AffectedPackage, 0, len(affected))
+	for _, pkg := range affected {
+		pkg.Indirect = !strings.HasPrefix(pkg.ImportPath, report.GoMod.Module.Path)
+		out = append(out, *pkg)
 	}
 
 	slices.SortFunc(out, func(a, b model.AffectedPackage) int {
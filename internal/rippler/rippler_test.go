@@ -0,0 +1,209 @@
+package rippler
+
+import (
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+)
+
+// testModule is the module path of the reports built by the tests, rooted at testRepository.
+const (
+	testModule     = "example.com/project"
+	testRepository = "/repo"
+)
+
+// testImportPath turns a path relative to the test module, e.g. "internal/db", into an import
+// path. Third-party import paths, such as "github.com/x/y", are returned unchanged.
+func testImportPath(rel string) string {
+	if rel == "." {
+		return testModule
+	}
+
+	if first, _, _ := strings.Cut(rel, "/"); strings.Contains(first, ".") {
+		return rel
+	}
+
+	return testModule + "/" + rel
+}
+
+// testPackage returns a package of the test module importing the given packages, all given
+// relative to the module, see testImportPath.
+func testPackage(rel string, imports ...string) model.Package {
+	pkg := model.Package{
+		Dir:        path.Join(testRepository, rel),
+		ImportPath: testImportPath(rel),
+		Name:       path.Base(rel),
+	}
+
+	for _, imported := range imports {
+		pkg.Imports = append(pkg.Imports, testImportPath(imported))
+	}
+
+	return pkg
+}
+
+// newTestReport returns a report over the given packages of the test module, with the given
+// direct changes.
+func newTestReport(pkgs []model.Package, changes ...Change) *Report {
+	return &Report{
+		GoMod:         model.GoMod{Module: model.GoModDependency{Path: testModule}},
+		RepositoryDir: testRepository,
+		ModuleDir:     testRepository,
+		AllPackages:   pkgs,
+		Changes:       changes,
+	}
+}
+
+// fileChange returns the change of a package caused by one of its files, e.g. "users/user.go".
+func fileChange(file string) Change {
+	return Change{
+		PackageName: testImportPath(path.Dir(file)),
+		Reasons:     []Reason{{Kind: ReasonFile, File: path.Join(testRepository, file)}},
+	}
+}
+
+// withAffected sets the affected packages of the report, given relative to the test module.
+func withAffected(report *Report, rels ...string) *Report {
+	report.AffectedPackages = nil
+
+	for _, rel := range rels {
+		report.AffectedPackages = append(report.AffectedPackages, model.AffectedPackage{ImportPath: testImportPath(rel)})
+	}
+
+	return report
+}
+
+func TestAffectedPackagesByFileChanges(t *testing.T) {
+	db := testPackage("internal/db")
+	db.GoFiles = []string{"db.go"}
+	db.TestGoFiles = []string{"db_test.go"}
+
+	cache := testPackage("internal/cache")
+	cache.GoFiles = []string{"cache.go"}
+	cache.XTestGoFiles = []string{"cache_test.go"}
+
+	report := newTestReport([]model.Package{db, cache})
+	report.DirtyFiles = []string{
+		testRepository + "/internal/db/db.go",
+		testRepository + "/internal/db/db_test.go",
+		testRepository + "/internal/cache/cache_test.go",
+		testRepository + "/README.md",
+	}
+
+	want := []Change{{
+		PackageName: testImportPath("internal/db"),
+		Reasons: []Reason{
+			{Kind: ReasonFile, File: testRepository + "/internal/db/db.go"},
+			{Kind: ReasonTestFile, File: testRepository + "/internal/db/db_test.go"},
+		},
+	}}
+
+	if got := (&Rippler{}).affectedPackagesByFileChanges(report); !reflect.DeepEqual(got, want) {
+		t.Errorf("affectedPackagesByFileChanges() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGetChangedReplacements(t *testing.T) {
+	replace := func(old, path, version string) model.GoModReplace {
+		return model.GoModReplace{
+			Old: model.GoModDependency{Path: old},
+			New: model.GoModDependency{Path: path, Version: version},
+		}
+	}
+
+	oldMod := model.GoMod{Replace: []model.GoModReplace{
+		replace("example.com/kept", "../kept", ""),
+		replace("example.com/updated", "example.com/fork", "v1.0.0"),
+		replace("example.com/removed", "../removed", ""),
+	}}
+
+	currentMod := model.GoMod{Replace: []model.GoModReplace{
+		replace("example.com/kept", "../kept", ""),
+		replace("example.com/updated", "example.com/fork", "v1.1.0"),
+		replace("example.com/added", "../added", ""),
+	}}
+
+	want := map[string]Reason{
+		"example.com/updated": {
+			Kind:       ReasonGoModReplace,
+			Module:     "example.com/updated",
+			OldVersion: "example.com/fork@v1.0.0",
+			NewVersion: "example.com/fork@v1.1.0",
+		},
+		"example.com/removed": {Kind: ReasonGoModReplace, Module: "example.com/removed", OldVersion: "../removed"},
+		"example.com/added":   {Kind: ReasonGoModReplace, Module: "example.com/added", NewVersion: "../added"},
+	}
+
+	if got := getChangedReplacements(currentMod, oldMod); !reflect.DeepEqual(got, want) {
+		t.Errorf("getChangedReplacements() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPropagateAffectedPackages(t *testing.T) {
+	origin := testImportPath("pkg/strutil")
+
+	affected := func(rel string, distance int, parents ...string) model.AffectedPackage {
+		pkg := model.AffectedPackage{ImportPath: testImportPath(rel), Distance: distance, Origin: origin}
+		for _, parent := range parents {
+			pkg.Parents = append(pkg.Parents, testImportPath(parent))
+		}
+
+		return pkg
+	}
+
+	tests := []struct {
+		name     string
+		maxDepth int
+		changes  []Change
+		want     []model.AffectedPackage
+	}{
+		{
+			name: "unlimited",
+			want: []model.AffectedPackage{
+				affected("cmd/api", 2, "internal/cache", "internal/db"),
+				affected("cmd/worker", 1, "pkg/strutil"),
+				affected("internal/cache", 1, "pkg/strutil"),
+				affected("internal/db", 1, "pkg/strutil"),
+				affected("pkg/strutil", 0),
+			},
+		},
+		{
+			name:     "max depth",
+			maxDepth: 1,
+			want: []model.AffectedPackage{
+				affected("cmd/worker", 1, "pkg/strutil"),
+				affected("internal/cache", 1, "pkg/strutil"),
+				affected("internal/db", 1, "pkg/strutil"),
+				affected("pkg/strutil", 0),
+			},
+		},
+		{
+			name:     "whole module change",
+			maxDepth: 1,
+			changes:  []Change{{PackageName: testModule + "/internal/...", Reasons: []Reason{{Kind: ReasonGlobalTrigger}}}},
+			want: []model.AffectedPackage{
+				{ImportPath: testImportPath("cmd/api"), Distance: 1, Parents: []string{testImportPath("internal/cache"), testImportPath("internal/db")}, Origin: testModule + "/internal/..."},
+				{ImportPath: testImportPath("internal/cache"), Origin: testModule + "/internal/..."},
+				{ImportPath: testImportPath("internal/db"), Origin: testModule + "/internal/..."},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := newDiamondReport()
+			if tt.changes != nil {
+				report.Changes = tt.changes
+			}
+
+			r := &Rippler{maxDepth: tt.maxDepth}
+
+			if got := r.propagateAffectedPackages(report); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("propagateAffectedPackages() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
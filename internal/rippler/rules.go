@@ -0,0 +1,297 @@
+package rippler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/glob"
+	"github.com/tangelo-labs/go-ripple/internal/model"
+)
+
+// FileRule declares that changes to any file matching Files affect Packages,
+// regardless of the packages those files belong to (if any).
+type FileRule struct {
+	// Name is an optional human-readable name used when explaining changes.
+	Name string
+
+	// Files is a list of path patterns, relative to the repository root.
+	Files []string
+
+	// Packages is a list of package patterns, either import paths or paths
+	// relative to the module root such as "./internal/db" or "./internal/...".
+	Packages []string
+}
+
+// String returns the rule name, or a description of its file patterns when unnamed.
+func (f FileRule) String() string {
+	if f.Name != "" {
+		return f.Name
+	}
+
+	return strings.Join(f.Files, ", ")
+}
+
+// affectedPackagesByRules determines which packages are affected by changed files matching
+// any of the configured file-to-package rules.
+func (r *Rippler) affectedPackagesByRules(report *Report) []Change {
+	affected := make([]Change, 0)
+
+	for i := range report.ChangedFiles {
+		rel := r.relativeToRepository(report.ChangedFiles[i])
+
+		for _, rule := range r.rules {
+			if _, ok := glob.MatchAny(rule.Files, rel); !ok {
+				continue
+			}
+
+			for _, pkg := range r.expandPackagePatterns(report, rule.Packages) {
+				affected = append(affected, Change{
+					PackageName: pkg,
+					Reasons: []Reason{{
+						Kind: ReasonRule,
+						File: report.ChangedFiles[i],
+						Rule: rule.String(),
+					}},
+				})
+			}
+		}
+	}
+
+	return affected
+}
+
+// globalTriggerChange checks whether any changed file matches a global trigger. If so, it
+// returns a single change, covering the whole module, that explains why every package is
+// considered affected.
+func (r *Rippler) globalTriggerChange(report *Report) (Change, bool) {
+	change := Change{PackageName: report.GoMod.Module.Path + "/..."}
+
+	for i := range report.ChangedFiles {
+		if pattern, ok := glob.MatchAny(r.triggers, r.relativeToRepository(report.ChangedFiles[i])); ok {
+			change.Reasons = append(change.Reasons, Reason{Kind: ReasonGlobalTrigger, File: report.ChangedFiles[i], Rule: pattern})
+		}
+	}
+
+	return change, len(change.Reasons) > 0
+}
+
+// allPackagesAffected marks every package of the project as affected.
+func allPackagesAffected(report *Report) []model.AffectedPackage {
+	out := make([]model.AffectedPackage, 0, len(report.AllPackages))
+
+	for i := range report.AllPackages {
+		out = append(out, model.AffectedPackage{
+			ImportPath: report.AllPackages[i].ImportPath,
+			Indirect:   !strings.HasPrefix(report.AllPackages[i].ImportPath, report.GoMod.Module.Path),
+			Origin:     report.Changes[0].PackageName,
+		})
+	}
+
+	slices.SortFunc(out, func(a, b model.AffectedPackage) int {
+		return strings.Compare(a.ImportPath, b.ImportPath)
+	})
+
+	return out
+}
+
+// expandPackagePatterns resolves the given package patterns into the import paths of the
+// project packages they match. Patterns not matching any known package are kept verbatim
+// (once resolved against the module path), so rules may also target external packages.
+func (r *Rippler) expandPackagePatterns(report *Report, patterns []string) []string {
+	out := make([]string, 0)
+
+	for _, pattern := range patterns {
+		found := false
+
+		for i := range report.AllPackages {
+			if matchPackagePattern(report.GoMod.Module.Path, pattern, report.AllPackages[i].ImportPath) {
+				out = append(out, report.AllPackages[i].ImportPath)
+				found = true
+			}
+		}
+
+		if resolved := resolvePackagePattern(report.GoMod.Module.Path, pattern); !found && !strings.HasSuffix(resolved, "/...") {
+			out = append(out, resolved)
+		}
+	}
+
+	return out
+}
+
+// checkPackagePatterns reports the configured package patterns, relative to the module or
+// within it, that do not match any package of the module, each as a *PatternError, so typos
+// are caught whether or not the patterns come into play. External import paths are not
+// checked, as rules may target packages of other modules.
+func (r *Rippler) checkPackagePatterns(report *Report) error {
+	var errs []error
+
+	check := func(field string, patterns []string) {
+		for _, pattern := range patterns {
+			if unmatchedPackagePattern(report, pattern) {
+				errs = append(errs, fmt.Errorf("%s: %w", field, &PatternError{Pattern: pattern}))
+			}
+		}
+	}
+
+	for _, rule := range r.rules {
+		check(fmt.Sprintf("rule %q", rule.String()), rule.Packages)
+	}
+
+	check("included packages", r.included)
+	check("excluded packages", r.excluded)
+
+	for _, app := range r.applications {
+		check(fmt.Sprintf("application %q", app.String()), app.Packages)
+	}
+
+	for _, artifact := range r.artifacts {
+		check(fmt.Sprintf("artifact %q", artifact.Name), artifact.Packages)
+	}
+
+	return errors.Join(errs...)
+}
+
+// CheckPackagePatterns lists the packages of the module and reports the configured package
+// patterns that match none of them, see checkPackagePatterns. It lets the configuration be
+// checked without analyzing any change.
+func (r *Rippler) CheckPackagePatterns(ctx context.Context) error {
+	mod, err := r.parseGoMod(ctx, r.goModFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+
+	allPackages, err := r.listAllPackages(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list all packages: %w", err)
+	}
+
+	return r.checkPackagePatterns(&Report{GoMod: mod, AllPackages: allPackages})
+}
+
+// unmatchedPackagePattern reports whether a package pattern resolves within the module, yet
+// does not match any of its packages.
+func unmatchedPackagePattern(report *Report, pattern string) bool {
+	modulePath := report.GoMod.Module.Path
+
+	prefix := strings.TrimSuffix(resolvePackagePattern(modulePath, pattern), "/...")
+	if prefix != modulePath && !strings.HasPrefix(prefix, modulePath+"/") {
+		return false
+	}
+
+	return !slices.ContainsFunc(report.AllPackages, func(pkg model.Package) bool {
+		return matchPackagePattern(modulePath, pattern, pkg.ImportPath)
+	})
+}
+
+// applyPackageFilters adds the always-included packages to the given affected set, and
+// removes the excluded ones from it. Exclusions take precedence over inclusions.
+func (r *Rippler) applyPackageFilters(report *Report, affected []model.AffectedPackage) []model.AffectedPackage {
+	seen := make(map[string]struct{})
+	for i := range affected {
+		seen[affected[i].ImportPath] = struct{}{}
+	}
+
+	for _, pkg := range r.expandPackagePatterns(report, r.included) {
+		if _, ok := seen[pkg]; !ok {
+			seen[pkg] = struct{}{}
+			affected = append(affected, model.AffectedPackage{
+				ImportPath: pkg,
+				Indirect:   !strings.HasPrefix(pkg, report.GoMod.Module.Path),
+			})
+		}
+	}
+
+	out := make([]model.AffectedPackage, 0, len(affected))
+
+	for i := range affected {
+		excluded := false
+
+		for _, pattern := range r.excluded {
+			if matchPackagePattern(report.GoMod.Module.Path, pattern, affected[i].ImportPath) {
+				excluded = true
+
+				break
+			}
+		}
+
+		if !excluded {
+			out = append(out, affected[i])
+		}
+	}
+
+	return out
+}
+
+// relativeToRepository returns the given absolute path relative to the repository root,
+// using forward slashes, so it can be matched against path patterns.
+func (r *Rippler) relativeToRepository(path string) string {
+	rel, err := filepath.Rel(r.repoRoot, path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// matchPackagePattern reports whether the given import path matches a package pattern.
+// Patterns may be relative to the module root ("./internal/db") and may end with "/..."
+// to match a package and all the packages below it.
+func matchPackagePattern(modulePath, pattern, importPath string) bool {
+	pattern = resolvePackagePattern(modulePath, pattern)
+
+	if prefix, ok := strings.CutSuffix(pattern, "/..."); ok {
+		return importPath == prefix || strings.HasPrefix(importPath, prefix+"/")
+	}
+
+	return importPath == pattern
+}
+
+// resolvePackagePattern turns a module-relative package pattern into an import path pattern.
+func resolvePackagePattern(modulePath, pattern string) string {
+	if pattern == "." {
+		return modulePath
+	}
+
+	if rest, ok := strings.CutPrefix(pattern, "./"); ok {
+		return modulePath + "/" + rest
+	}
+
+	return pattern
+}
+
+// ResolvePackage turns a package given as an import path or relative to the module root
+// (e.g. "./users" or "users") into an import path.
+func (r *Report) ResolvePackage(pkg string) string {
+	resolved := strings.TrimSuffix(resolvePackagePattern(r.GoMod.Module.Path, pkg), "/")
+
+	for i := range r.AllPackages {
+		if r.AllPackages[i].ImportPath == resolved {
+			return resolved
+		}
+	}
+
+	for i := range r.AllPackages {
+		if r.AllPackages[i].ImportPath == r.GoMod.Module.Path+"/"+resolved {
+			return r.AllPackages[i].ImportPath
+		}
+	}
+
+	return resolved
+}
+
+// DirectChanges returns the changes directly affecting the given package.
+func (r *Report) DirectChanges(pkg string) []Change {
+	out := make([]Change, 0)
+
+	for i := range r.Changes {
+		if matchPackagePattern(r.GoMod.Module.Path, r.Changes[i].PackageName, pkg) {
+			out = append(out, r.Changes[i])
+		}
+	}
+
+	return out
+}
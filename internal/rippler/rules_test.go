@@ -0,0 +1,126 @@
+package rippler
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+)
+
+func TestGlobalTriggerChange(t *testing.T) {
+	r := &Rippler{repoRoot: testRepository, triggers: []string{"Makefile", "build/**"}}
+
+	tests := []struct {
+		name    string
+		changed []string
+		want    Change
+		wantOK  bool
+	}{
+		{
+			name:    "no trigger",
+			changed: []string{"users/user.go", "cmd/build/main.go"},
+			want:    Change{PackageName: testModule + "/..."},
+		},
+		{
+			name:    "triggers",
+			changed: []string{"users/user.go", "Makefile", "build/ci/lint.yaml"},
+			want: Change{
+				PackageName: testModule + "/...",
+				Reasons: []Reason{
+					{Kind: ReasonGlobalTrigger, File: testRepository + "/Makefile", Rule: "Makefile"},
+					{Kind: ReasonGlobalTrigger, File: testRepository + "/build/ci/lint.yaml", Rule: "build/**"},
+				},
+			},
+			wantOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := newTestReport(nil)
+			for _, file := range tt.changed {
+				report.ChangedFiles = append(report.ChangedFiles, testRepository+"/"+file)
+			}
+
+			got, ok := r.globalTriggerChange(report)
+			if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("globalTriggerChange() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAllPackagesAffected(t *testing.T) {
+	report := newTestReport(
+		[]model.Package{testPackage("users"), testPackage("github.com/x/y"), testPackage("api")},
+		Change{PackageName: testModule + "/...", Reasons: []Reason{{Kind: ReasonGlobalTrigger, Rule: "Makefile"}}},
+	)
+
+	want := []model.AffectedPackage{
+		{ImportPath: testImportPath("api"), Origin: testModule + "/..."},
+		{ImportPath: testImportPath("users"), Origin: testModule + "/..."},
+		{ImportPath: "github.com/x/y", Indirect: true, Origin: testModule + "/..."},
+	}
+
+	if got := allPackagesAffected(report); !reflect.DeepEqual(got, want) {
+		t.Errorf("allPackagesAffected() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCheckPackagePatterns(t *testing.T) {
+	report := newTestReport([]model.Package{testPackage("users"), testPackage("internal/db"), testPackage(".")})
+
+	tests := []struct {
+		name     string
+		rippler  *Rippler
+		wantErrs []string
+	}{
+		{
+			name: "matching patterns",
+			rippler: &Rippler{
+				rules:    []FileRule{{Name: "schema", Packages: []string{"./internal/db", "./internal/...", "."}}},
+				included: []string{testModule + "/users"},
+				excluded: []string{"github.com/x/y", "./..."},
+			},
+		},
+		{
+			name: "unmatched patterns",
+			rippler: &Rippler{
+				rules:        []FileRule{{Files: []string{"schema/**"}, Packages: []string{"./internal/dbs"}}},
+				included:     []string{testModule + "/user"},
+				excluded:     []string{"./vendor/..."},
+				applications: []ApplicationRoot{{Name: "api", Packages: []string{"./cmd/api"}}},
+				artifacts:    []ArtifactRule{{Name: "worker", Packages: []string{"./cmd/worker"}}},
+			},
+			wantErrs: []string{
+				`rule "schema/**": package pattern ./internal/dbs does not match any package`,
+				"included packages: package pattern " + testModule + "/user does not match any package",
+				"excluded packages: package pattern ./vendor/... does not match any package",
+				`application "api": package pattern ./cmd/api does not match any package`,
+				`artifact "worker": package pattern ./cmd/worker does not match any package`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rippler.checkPackagePatterns(report)
+
+			var got []string
+			if err != nil {
+				got = strings.Split(err.Error(), "\n")
+			}
+
+			if !reflect.DeepEqual(got, tt.wantErrs) {
+				t.Errorf("checkPackagePatterns() = %q, want %q", got, tt.wantErrs)
+			}
+
+			var pErr *PatternError
+			if err != nil && !errors.As(err, &pErr) {
+				t.Errorf("checkPackagePatterns() = %v, want a *PatternError", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Package shard splits weighted work items, such as packages to test, into a number
+// of balanced shards that can run in parallel.
+package shard
+
+import (
+	"slices"
+	"strings"
+)
+
+// Item is a unit of work to be assigned to a shard.
+type Item struct {
+	// Key identifies the item, e.g. a package import path.
+	Key string
+
+	// Weight is the estimated cost of the item, e.g. a duration in seconds.
+	Weight float64
+}
+
+// Shard is a group of items meant to be processed together.
+type Shard struct {
+	// Items holds the keys of the items in this shard, sorted.
+	Items []string
+
+	// Weight is the sum of the weights of the items in this shard.
+	Weight float64
+}
+
+// Balance distributes the given items into at most n shards, trying to minimize the weight
+// of the heaviest shard. It uses the longest-processing-time-first heuristic: items are
+// taken heaviest first and each one is assigned to the currently lightest shard.
+//
+// The result is deterministic for the same input. Empty shards are dropped, so fewer than
+// n shards are returned when there are fewer items than shards.
+func Balance(items []Item, n int) []Shard {
+	if n < 1 {
+		n = 1
+	}
+
+	sorted := slices.Clone(items)
+	slices.SortFunc(sorted, func(a, b Item) int {
+		if a.Weight != b.Weight {
+			if a.Weight > b.Weight {
+				return -1
+			}
+
+			return 1
+		}
+
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	shards := make([]Shard, n)
+
+	for i := range sorted {
+		lightest := 0
+
+		for j := 1; j < n; j++ {
+			if shards[j].Weight < shards[lightest].Weight ||
+				(shards[j].Weight == shards[lightest].Weight && len(shards[j].Items) < len(shards[lightest].Items)) {
+				lightest = j
+			}
+		}
+
+		shards[lightest].Items = append(shards[lightest].Items, sorted[i].Key)
+		shards[lightest].Weight += sorted[i].Weight
+	}
+
+	out := make([]Shard, 0, n)
+
+	for i := range shards {
+		if len(shards[i].Items) == 0 {
+			continue
+		}
+
+		slices.Sort(shards[i].Items)
+		out = append(out, shards[i])
+	}
+
+	return out
+}
@@ -0,0 +1,101 @@
+package shard
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBalance(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []Item
+		n     int
+		want  []Shard
+	}{
+		{
+			name: "no items",
+			n:    3,
+			want: []Shard{},
+		},
+		{
+			name:  "single shard",
+			items: []Item{{Key: "b", Weight: 1}, {Key: "a", Weight: 2}},
+			n:     1,
+			want:  []Shard{{Items: []string{"a", "b"}, Weight: 3}},
+		},
+		{
+			name:  "non-positive count means one shard",
+			items: []Item{{Key: "a", Weight: 1}, {Key: "b", Weight: 1}},
+			n:     0,
+			want:  []Shard{{Items: []string{"a", "b"}, Weight: 2}},
+		},
+		{
+			name:  "fewer items than shards",
+			items: []Item{{Key: "a", Weight: 1}, {Key: "b", Weight: 1}},
+			n:     4,
+			want:  []Shard{{Items: []string{"a"}, Weight: 1}, {Items: []string{"b"}, Weight: 1}},
+		},
+		{
+			name: "heaviest first onto the lightest shard",
+			items: []Item{
+				{Key: "a", Weight: 5},
+				{Key: "b", Weight: 4},
+				{Key: "c", Weight: 3},
+				{Key: "d", Weight: 2},
+				{Key: "e", Weight: 2},
+			},
+			n: 2,
+			want: []Shard{
+				{Items: []string{"a", "d", "e"}, Weight: 9},
+				{Items: []string{"b", "c"}, Weight: 7},
+			},
+		},
+		{
+			name: "equal weights spread by count",
+			items: []Item{
+				{Key: "a", Weight: 1},
+				{Key: "b", Weight: 1},
+				{Key: "c", Weight: 1},
+				{Key: "d", Weight: 1},
+			},
+			n: 2,
+			want: []Shard{
+				{Items: []string{"a", "c"}, Weight: 2},
+				{Items: []string{"b", "d"}, Weight: 2},
+			},
+		},
+		{
+			name: "zero weights spread by count",
+			items: []Item{
+				{Key: "a"},
+				{Key: "b"},
+				{Key: "c"},
+			},
+			n: 3,
+			want: []Shard{
+				{Items: []string{"a"}},
+				{Items: []string{"b"}},
+				{Items: []string{"c"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Balance(tt.items, tt.n)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Balance() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBalanceIsDeterministic(t *testing.T) {
+	items := []Item{{Key: "c", Weight: 1}, {Key: "a", Weight: 1}, {Key: "b", Weight: 1}}
+	reversed := []Item{items[2], items[1], items[0]}
+
+	if got, want := Balance(reversed, 2), Balance(items, 2); !reflect.DeepEqual(got, want) {
+		t.Errorf("Balance() depends on the input order: %+v, want %+v", got, want)
+	}
+}
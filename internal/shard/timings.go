@@ -0,0 +1,101 @@
+package shard
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Timings holds the test durations of packages, as learned from previous test runs.
+type Timings struct {
+	// Durations maps package import paths to their (average) test duration.
+	Durations map[string]time.Duration
+
+	// Default is the duration estimated for packages without history. When zero, the
+	// average of the known durations is used instead, or one second if none is known.
+	Default time.Duration
+}
+
+// testEvent is the subset of the `go test -json` event (see `go doc test2json`) needed
+// to learn package durations.
+type testEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+}
+
+// LoadTimings reads the given `go test -json` output files and learns the test duration
+// of each package from their package-level "pass" and "fail" events. Packages appearing
+// several times, e.g. in files from several CI runs, get their average duration.
+// Lines that are not JSON events, such as build output, are skipped.
+func LoadTimings(paths ...string) (*Timings, error) {
+	totals := make(map[string]float64)
+	counts := make(map[string]int)
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read test timings: %w", err)
+		}
+
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+		for scanner.Scan() {
+			line := bytes.TrimSpace(scanner.Bytes())
+			if len(line) == 0 || line[0] != '{' {
+				continue
+			}
+
+			var event testEvent
+			if err := json.Unmarshal(line, &event); err != nil {
+				continue
+			}
+
+			if event.Test != "" || event.Package == "" || (event.Action != "pass" && event.Action != "fail") {
+				continue
+			}
+
+			totals[event.Package] += event.Elapsed
+			counts[event.Package]++
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to scan test timings in %s: %w", path, err)
+		}
+	}
+
+	timings := &Timings{Durations: make(map[string]time.Duration, len(totals))}
+
+	for pkg, total := range totals {
+		timings.Durations[pkg] = time.Duration(total / float64(counts[pkg]) * float64(time.Second))
+	}
+
+	return timings, nil
+}
+
+// Estimate returns the expected test duration of the given package.
+func (t *Timings) Estimate(pkg string) time.Duration {
+	if d, ok := t.Durations[pkg]; ok {
+		return d
+	}
+
+	if t.Default > 0 {
+		return t.Default
+	}
+
+	if len(t.Durations) == 0 {
+		return time.Second
+	}
+
+	var total time.Duration
+	for _, d := range t.Durations {
+		total += d
+	}
+
+	return total / time.Duration(len(t.Durations))
+}
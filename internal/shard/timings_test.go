@@ -0,0 +1,136 @@
+package shard
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLoadTimings(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+		want  map[string]time.Duration
+	}{
+		{
+			name: "package events",
+			files: []string{
+				`{"Action":"run","Package":"example.com/a","Test":"TestA"}
+{"Action":"pass","Package":"example.com/a","Test":"TestA","Elapsed":0.5}
+{"Action":"pass","Package":"example.com/a","Elapsed":1.5}
+{"Action":"fail","Package":"example.com/b","Elapsed":2}
+{"Action":"skip","Package":"example.com/c","Elapsed":3}
+`,
+			},
+			want: map[string]time.Duration{
+				"example.com/a": 1500 * time.Millisecond,
+				"example.com/b": 2 * time.Second,
+			},
+		},
+		{
+			name: "non-JSON lines skipped",
+			files: []string{
+				`# example.com/a
+./a.go:1: build output
+{"Action":"pass","Package":"example.com/a","Elapsed":1}
+{not json}
+
+`,
+			},
+			want: map[string]time.Duration{"example.com/a": time.Second},
+		},
+		{
+			name: "averaged across files",
+			files: []string{
+				`{"Action":"pass","Package":"example.com/a","Elapsed":1}` + "\n",
+				`{"Action":"pass","Package":"example.com/a","Elapsed":3}` + "\n" +
+					`{"Action":"pass","Package":"example.com/b","Elapsed":4}` + "\n",
+			},
+			want: map[string]time.Duration{
+				"example.com/a": 2 * time.Second,
+				"example.com/b": 4 * time.Second,
+			},
+		},
+		{
+			name:  "empty file",
+			files: []string{""},
+			want:  map[string]time.Duration{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			paths := make([]string, 0, len(tt.files))
+
+			for i, content := range tt.files {
+				path := filepath.Join(dir, fmt.Sprintf("timings-%d.json", i))
+				if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+
+				paths = append(paths, path)
+			}
+
+			got, err := LoadTimings(paths...)
+			if err != nil {
+				t.Fatalf("LoadTimings() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got.Durations, tt.want) {
+				t.Errorf("LoadTimings() = %v, want %v", got.Durations, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadTimingsMissingFile(t *testing.T) {
+	if _, err := LoadTimings(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("LoadTimings() error = nil, want an error for a missing file")
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		name    string
+		timings Timings
+		pkg     string
+		want    time.Duration
+	}{
+		{
+			name:    "known package",
+			timings: Timings{Durations: map[string]time.Duration{"a": 3 * time.Second}, Default: time.Minute},
+			pkg:     "a",
+			want:    3 * time.Second,
+		},
+		{
+			name:    "default duration",
+			timings: Timings{Durations: map[string]time.Duration{"a": 3 * time.Second}, Default: time.Minute},
+			pkg:     "b",
+			want:    time.Minute,
+		},
+		{
+			name:    "average of known durations",
+			timings: Timings{Durations: map[string]time.Duration{"a": time.Second, "b": 3 * time.Second}},
+			pkg:     "c",
+			want:    2 * time.Second,
+		},
+		{
+			name:    "no history",
+			timings: Timings{},
+			pkg:     "a",
+			want:    time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.timings.Estimate(tt.pkg); got != tt.want {
+				t.Errorf("Estimate(%q) = %v, want %v", tt.pkg, got, tt.want)
+			}
+		})
+	}
+}
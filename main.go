@@ -17,15 +17,63 @@
 // - Outputs the list of all affected packages in various formats:
 //   - Plain text (one package per line).
 //   - JSON array of affected packages.
+//   - JSON job matrix that packs affected packages into balanced shards, for CI fan-out
+//     (e.g. GitHub Actions `strategy.matrix: ${{ fromJSON(...) }}`).
+//   - JSON list of the affected binaries (main packages), along with the changes reaching them.
+//   - JSON list of the affected deployable artifacts (as configured), along with the package chain pulling them in.
+//   - Graphviz DOT graph of the changed packages, their dependents and the imports between them.
+//   - Mermaid graph of the same, rendered inline by GitHub and GitLab in pull request descriptions.
+//   - Markdown summary for pull request comments: counts, direct changes, module changes and affected packages.
+//   - Self-contained HTML report, with a searchable package list and a clickable reverse-dependency tree.
+//   - Full, versioned JSON report for machine consumers, described by the JSON Schema printed by "schema".
+//   - GitLab child pipeline with one test job per shard, application or module (or a no-op job).
+//   - Buildkite pipeline steps building and testing each shard, application or module.
+//   - Custom text/template over the whole report, for any other shape (e.g. -coverpkg lists or YAML).
 //   - JSON plan format that groups affected packages by application (if applicable) and lists others separately.
+//     Applications are the main packages under a "cmd" directory, unless configured otherwise.
 //
 // Usage:
 //
 //	go run tools/dev/go-ripple/main.go [-b <base>] [-o <output>]
+//	go run tools/dev/go-ripple/main.go config validate [--config <file>]
+//	go run tools/dev/go-ripple/main.go schema
+//	go run tools/dev/go-ripple/main.go run [-b <base>] [--dirs] [-p <n>] -- <command> [args...]
+//	go run tools/dev/go-ripple/main.go why [-b <base>] [--all] <package>
+//	go run tools/dev/go-ripple/main.go impact [-o <output>] <package-or-file>...
 //
 // Example:
 //
 //	go run tools/dev/go-ripple/main.go -b origin/main -o json
+//	go run tools/dev/go-ripple/main.go -o plain=affected.txt -o report-json=ripple.json -o markdown=comment.md
+//	go run tools/dev/go-ripple/main.go run -b origin/main -- go test -race {}
+//	go run tools/dev/go-ripple/main.go why -b origin/main ./services/reporting
+//	go run tools/dev/go-ripple/main.go impact -o test-plan ./internal/auth
+//
+// Configuration:
+//
+// Settings shared by every invocation can be declared in a ".go-ripple.yaml" file, placed either at the
+// module root or at the repository root (the former wins). Command line flags always take precedence:
+//
+//	base: origin/main
+//	output: json
+//	ignore: ["docs/", "*.md"]
+//	ignoreGenerated: true
+//	globalTriggers: ["Makefile", ".github/workflows/**"]
+//	include: ["./tests/smoke"]
+//	exclude: ["./tools/..."]
+//	maxDepth: 0
+//	rules:
+//	  - name: migrations
+//	    files: ["migrations/**"]
+//	    packages: ["./internal/db"]
+//	applications:
+//	  - name: billing
+//	    packages: ["./services/billing/..."]
+//	artifacts:
+//	  - name: billing
+//	    kind: service
+//	    packages: ["./cmd/billing"]
+//	    files: ["deploy/billing/**"]
 //
 // Dependencies:
 //
@@ -33,9 +81,37 @@
 // - The project must have a valid go.mod and go.sum at its root.
 // - The base reference (e.g. origin/main) must be fetchable by Git.
 //
+// Exit Status:
+//
+// 0 on success, 1 on unexpected errors, 2 on invalid arguments, 3 on configuration errors, 4 when a git
+// command fails, 5 when a go command fails, 6 when a file cannot be read or written, and 10 when some
+// package is affected and --exit-code is given.
+//
 // Argument Flags:
 //
-// -b, --base   The Git base branch or commit to compare against. Defaults to "origin/main".
+// -b, --base          The Git base branch or commit to compare against. Defaults to "origin/main".
+// --ignore            Path pattern of changed files to ignore (e.g. "docs/" or "*.md"). Can be repeated.
+// --ignore-generated  Ignore changed generated files when their generator input did not change.
+// --global-trigger    Path pattern of files that, when changed, affect every package. Can be repeated.
+// --max-depth         Only propagate changes to packages at most N imports away from a direct change.
+// --shards            Number of jobs to spread the affected packages over, for the test-matrix output.
+// --balance           How to balance test-matrix shards: "packages" (default), "test-files" or "timings".
+// --timings           Output of a previous "go test -json" run, used to balance shards by test duration.
+// --default-duration  Test duration estimated for packages without timing history.
+// --collapse          How to merge packages in graph outputs: "none" (default), "dir" or "module".
+// --max-nodes         Maximum number of nodes in the mermaid output, the others being summarized. Defaults to 50.
+// --focus             Only show the import paths leading to this package in the explain output.
+// --exit-code         Exit with status 10 when some package is affected, 0 when none is.
+// --github-actions    Publish step outputs and a job summary to GitHub Actions. Automatic when GITHUB_ACTIONS is set.
+// --group-by          How to split affected packages into pipeline jobs: "shard" (default), "application" or "module".
+// --job-template      File holding the Go text/template rendering each job of the gitlab-pipeline output.
+// --build-command     Command template building each group in the buildkite output, before its test step.
+// --test-command      Command template testing each group in the buildkite output. Defaults to go test.
+// --build-queue       Agents queue of the buildkite build steps.
+// --test-queue        Agents queue of the buildkite test steps.
+// --template          Go text/template rendering the report, for the template output.
+// --template-file     File holding the Go text/template rendering the report, for the template output.
+// --config            Path to a configuration file. Defaults to ".go-ripple.yaml" at the module or repository root.
 //
 // This script is intended for monorepos or large Go projects where full builds or tests
 // are expensive and should be scoped to only affected components.
@@ -43,48 +119,526 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/alexflint/go-arg"
+	"github.com/tangelo-labs/go-ripple/internal/config"
 	"github.com/tangelo-labs/go-ripple/internal/rippler"
 	"github.com/tangelo-labs/go-ripple/internal/rippler/printers"
-	"github.com/alexflint/go-arg"
+	"github.com/tangelo-labs/go-ripple/internal/shard"
+)
+
+const (
+	defaultBase         = "origin/main"
+	defaultOutputFormat = "plain"
 )
 
+// outputFormats lists the accepted values for the --output flag.
+var outputFormats = []string{"plain", "json", "test-plan", "test-matrix", "explain", "binaries", "artifacts", "dot", "mermaid", "markdown", "html", "report-json", "template", "gitlab-pipeline", "buildkite"}
+
 // Arguments holds the command line arguments for the tool.
 type Arguments struct {
-	Path         string `arg:"positional" placeholder:"PATH" help:"The path to the Go project directory (holding a go.mod file). Defaults to the current directory if not specified." default:"."`
-	Base         string `arg:"-b,--base" help:"The base commit or branch to compare against. This is passed to 'git diff'. Defaults to 'origin/main' if not specified." default:"origin/main"`
-	OutputFormat string `arg:"-o,--output" help:"How to present the results, valid options are: plain, json, test-plan, test-matrix, explain" default:"plain"`
+	AnalysisArguments
+
+	Path string `arg:"positional" placeholder:"PATH" help:"The path to the Go project directory (holding a go.mod file). Defaults to the current directory if not specified." default:"."`
+
+	OutputArguments
+
+	ExitCode bool `arg:"--exit-code" help:"Exit with status 10 when some package is affected, 0 when none is."`
+
+	GitHubActions *bool `arg:"--github-actions" help:"Publish the results to the GitHub Actions workflow: step outputs and job summary. Enabled automatically when GITHUB_ACTIONS is set, use --github-actions=false to opt out, e.g. for extra invocations in the same step."`
+}
+
+// OutputArguments holds the command line arguments driving how reports are printed, shared
+// by every command printing them.
+type OutputArguments struct {
+	OutputFormat []string `arg:"-o,--output,separate" placeholder:"FORMAT[=PATH]" help:"How to present the results, valid options are: plain, json, test-plan, test-matrix, explain, binaries, artifacts, dot, mermaid, markdown, html, report-json, template, gitlab-pipeline, buildkite. Can be repeated, each format being written to the given file, or to the standard output. Defaults to 'plain' if not specified."`
+
+	Shards          int           `arg:"--shards" placeholder:"N" help:"Number of jobs to spread the affected packages over, for the test-matrix output." default:"1"`
+	Balance         string        `arg:"--balance" placeholder:"STRATEGY" help:"How to balance test-matrix shards, valid options are: packages, test-files, timings. Defaults to 'timings' when --timings is given, 'packages' otherwise."`
+	Timings         []string      `arg:"--timings,separate" placeholder:"FILE" help:"Output of a previous 'go test -json' run, used to learn package test durations. Can be repeated."`
+	DefaultDuration time.Duration `arg:"--default-duration" placeholder:"DURATION" help:"Test duration estimated for packages without timing history. Defaults to the average known duration."`
+
+	Collapse string `arg:"--collapse" placeholder:"MODE" help:"How to merge packages in graph outputs, valid options are: none, dir, module." default:"none"`
+	MaxNodes int    `arg:"--max-nodes" placeholder:"N" help:"Maximum number of nodes in the mermaid output, the others being summarized by directory. Zero means no limit." default:"50"`
+
+	Focus string `arg:"--focus" placeholder:"PACKAGE" help:"Only show the import paths leading to this package in the explain output, as an import path or relative to the module root."`
+
+	Template     string `arg:"--template" placeholder:"TEMPLATE" help:"Go text/template rendering the report, for the template output."`
+	TemplateFile string `arg:"--template-file" placeholder:"FILE" help:"File holding the Go text/template rendering the report, for the template output."`
+
+	GroupBy     string `arg:"--group-by" placeholder:"GROUPING" help:"How to split affected packages into pipeline jobs, valid options are: shard, application, module." default:"shard"`
+	JobTemplate string `arg:"--job-template" placeholder:"FILE" help:"File holding the Go text/template rendering the YAML body of each job, for the gitlab-pipeline output. Defaults to running go test."`
+
+	BuildCommand string `arg:"--build-command" placeholder:"TEMPLATE" help:"Go text/template of the command building each group, for the buildkite output. No build step is generated when empty."`
+	BuildQueue   string `arg:"--build-queue" placeholder:"QUEUE" help:"Agents queue of the build steps, for the buildkite output."`
+	TestCommand  string `arg:"--test-command" placeholder:"TEMPLATE" help:"Go text/template of the command testing each group, for the buildkite output. Defaults to running go test."`
+	TestQueue    string `arg:"--test-queue" placeholder:"QUEUE" help:"Agents queue of the test steps, for the buildkite output."`
+}
+
+// AnalysisArguments holds the command line arguments driving the analysis, shared by every
+// command that runs it.
+type AnalysisArguments struct {
+	Base   string `arg:"-b,--base" help:"The base commit or branch to compare against. This is passed to 'git diff'. Defaults to 'origin/main' if not specified."`
+	Config string `arg:"--config" placeholder:"FILE" help:"Path to the configuration file. Defaults to .go-ripple.yaml at the module root or the repository root."`
+
+	Ignore          []string `arg:"--ignore,separate" placeholder:"PATTERN" help:"Path pattern of changed files to ignore, relative to the repository root (e.g. 'docs/' or '*.md'). Can be repeated, and adds to the configured ones."`
+	IgnoreGenerated bool     `arg:"--ignore-generated" help:"Ignore changed generated files (carrying a 'Code generated ... DO NOT EDIT.' header) when no other file changed in their directory."`
+	GlobalTriggers  []string `arg:"--global-trigger,separate" placeholder:"PATTERN" help:"Path pattern of files that, when changed, affect every package (e.g. 'Makefile'). Can be repeated, and adds to the configured ones."`
+	MaxDepth        *int     `arg:"--max-depth" placeholder:"N" help:"Only propagate changes to packages at most N imports away from a direct change, for quick local checks. Zero means no limit, overriding the configured one."`
+}
+
+// ConfigValidateArguments holds the command line arguments for the "config validate" command.
+type ConfigValidateArguments struct {
+	Path   string `arg:"positional" placeholder:"PATH" help:"The path to the Go project directory (holding a go.mod file). Defaults to the current directory if not specified." default:"."`
+	Config string `arg:"--config" placeholder:"FILE" help:"Path to the configuration file. Defaults to .go-ripple.yaml at the module root or the repository root."`
+}
+
+// commands maps the name of each subcommand to its entry point, which receives the
+// remaining command line arguments. A directory named like a subcommand can still be
+// analyzed by prefixing it with "./".
+var commands = map[string]func(args []string){
+	"config": configCommand,
+	"impact": impactCommand,
+	"run":    runCommand,
+	"schema": schemaCommand,
+	"why":    whyCommand,
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		if cmd, ok := commands[os.Args[1]]; ok {
+			cmd(os.Args[2:])
+
+			return
+		}
+	}
+
 	var args Arguments
 	arg.MustParse(&args)
 
-	var printer rippler.ReportPrinter
+	cfg, err := loadConfig(args.Path, args.Config)
+	if err != nil {
+		fatal(exitConfig, "Failed to load configuration: %v\n", err)
+	}
+
+	if cErr := applyCIBase(cfg, &args.AnalysisArguments); cErr != nil {
+		fatal(exitStatus(cErr), "%v\n", cErr)
+	}
+
+	if aErr := applyArguments(cfg, &args.AnalysisArguments); aErr != nil {
+		fatal(exitUsage, "%v\n", aErr)
+	}
+
+	outputs, err := newOutputs(args.OutputFormat, firstNonEmpty(cfg.Output, defaultOutputFormat), &args.OutputArguments)
+	if err != nil {
+		fatal(usageStatus(err), "%v\n", err)
+	}
+
+	report, err := analyze(context.TODO(), cfg, args.Path)
+	if err != nil {
+		fatal(exitStatus(err), "%v\n", err)
+	}
 
-	switch args.OutputFormat {
+	if wErr := writeOutputs(report, outputs); wErr != nil {
+		fatal(exitStatus(wErr), "%v\n", wErr)
+	}
+
+	if githubActions(&args) {
+		if gErr := writeGitHubActions(report, &args.OutputArguments); gErr != nil {
+			fatal(exitStatus(gErr), "%v\n", gErr)
+		}
+	}
+
+	if args.ExitCode && len(report.AffectedPackages) > 0 {
+		os.Exit(exitAffected)
+	}
+}
+
+// newPrinter creates the report printer for the given output format. Terminal tells whether
+// the report is printed to a terminal, so it may be colored.
+func newPrinter(format string, args *OutputArguments, terminal bool) (rippler.ReportPrinter, error) {
+	switch format {
 	case "plain":
-		printer = printers.NewPlainPrinter()
+		return printers.NewPlainPrinter(), nil
 	case "json":
-		printer = printers.NewJSONPrinter()
+		return printers.NewJSONPrinter(), nil
 	case "explain":
-		printer = printers.NewExplainPrinter()
+		return printers.NewExplainPrinter(args.Focus, terminal), nil
+	case "test-plan":
+		return printers.NewTestPlanPrinter(), nil
+	case "test-matrix":
+		return newTestMatrixPrinter(args)
+	case "binaries":
+		return printers.NewBinariesPrinter(), nil
+	case "artifacts":
+		return printers.NewArtifactsPrinter(), nil
+	case "dot":
+		collapse, err := graphCollapse(args.Collapse)
+		if err != nil {
+			return nil, err
+		}
+
+		return printers.NewDOTPrinter(collapse), nil
+	case "mermaid":
+		collapse, err := graphCollapse(args.Collapse)
+		if err != nil {
+			return nil, err
+		}
+
+		return printers.NewMermaidPrinter(collapse, args.MaxNodes), nil
+	case "markdown":
+		return printers.NewMarkdownPrinter(), nil
+	case "html":
+		return printers.NewHTMLPrinter(), nil
+	case "report-json":
+		return printers.NewReportJSONPrinter(), nil
+	case "template":
+		return newTemplatePrinter(args)
+	case "gitlab-pipeline":
+		return newGitLabPipelinePrinter(args)
+	case "buildkite":
+		grouping, err := newGrouping(args)
+		if err != nil {
+			return nil, err
+		}
+
+		return printers.NewBuildkitePrinter(grouping, printers.BuildkiteSteps{
+			BuildCommand: args.BuildCommand,
+			BuildQueue:   args.BuildQueue,
+			TestCommand:  args.TestCommand,
+			TestQueue:    args.TestQueue,
+		})
+	default:
+		return nil, fmt.Errorf("invalid output format: %s. Valid options are: %s", format, strings.Join(outputFormats, ", "))
+	}
+}
+
+// analyze runs the ripple detection on the Go project at the given path.
+func analyze(ctx context.Context, cfg *config.Config, path string) (*rippler.Report, error) {
+	rip, err := rippler.NewRippler(cfg.Base, path, ripplerOptions(cfg)...)
+	if err != nil {
+		return nil, configError{fmt.Errorf("failed to initialize rippler: %w", err)}
+	}
+
+	report, err := rip.Changes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changes: %w", err)
+	}
+
+	return report, nil
+}
+
+// applyArguments merges the analysis arguments into the given configuration, flags
+// taking precedence over configured values, and fills in the defaults.
+func applyArguments(cfg *config.Config, args *AnalysisArguments) error {
+	cfg.Base = firstNonEmpty(args.Base, cfg.Base, defaultBase)
+	cfg.Ignore = append(cfg.Ignore, args.Ignore...)
+	cfg.IgnoreGenerated = cfg.IgnoreGenerated || args.IgnoreGenerated
+	cfg.GlobalTriggers = append(cfg.GlobalTriggers, args.GlobalTriggers...)
+
+	if args.MaxDepth != nil {
+		if *args.MaxDepth < 0 {
+			return fmt.Errorf("--max-depth must not be negative, got %d", *args.MaxDepth)
+		}
+
+		cfg.MaxDepth = *args.MaxDepth
+	}
+
+	return nil
+}
+
+// applyCIBase picks the base from the CI environment, GitHub Actions or GitLab CI, unless
+// given on the command line or configured.
+func applyCIBase(cfg *config.Config, args *AnalysisArguments) error {
+	if err := applyGitHubBase(cfg, args); err != nil {
+		return err
+	}
+
+	applyGitLabBase(cfg, args)
+
+	return nil
+}
+
+// newTestMatrixPrinter creates the test-matrix printer, loading test timings when needed.
+func newTestMatrixPrinter(args *OutputArguments) (rippler.ReportPrinter, error) {
+	balance, timings, err := matrixBalance(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return printers.NewTestMatrixPrinter(args.Shards, balance, timings), nil
+}
+
+// matrixBalance parses the --balance flag, loading test timings when needed.
+func matrixBalance(args *OutputArguments) (printers.MatrixBalance, *shard.Timings, error) {
+	balance := printers.MatrixBalance(args.Balance)
+
+	if balance == "" {
+		balance = printers.BalanceByPackages
+
+		if len(args.Timings) > 0 {
+			balance = printers.BalanceByTimings
+		}
+	}
+
+	switch balance {
+	case printers.BalanceByPackages, printers.BalanceByTestFiles:
+		return balance, nil, nil
+	case printers.BalanceByTimings:
+		if len(args.Timings) == 0 {
+			return "", nil, fmt.Errorf("balancing by %s requires --timings", printers.BalanceByTimings)
+		}
+
+		timings, err := shard.LoadTimings(args.Timings...)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to load test timings: %w", err)
+		}
+
+		timings.Default = args.DefaultDuration
+
+		return balance, timings, nil
 	default:
-		log.Fatalf("Invalid output format: %s. Valid options are: plain, json, test-plan, test-matrix, explain", args.OutputFormat)
+		return "", nil, fmt.Errorf("invalid balance strategy: %s. Valid options are: %s, %s, %s", args.Balance, printers.BalanceByPackages, printers.BalanceByTestFiles, printers.BalanceByTimings)
 	}
+}
 
-	rip, err := rippler.NewRippler(args.Base, args.Path)
+// newGrouping parses the flags splitting affected packages into pipeline jobs.
+func newGrouping(args *OutputArguments) (printers.Grouping, error) {
+	grouping := printers.Grouping{By: printers.GroupBy(args.GroupBy), Shards: args.Shards}
+
+	switch grouping.By {
+	case printers.GroupByShard:
+		balance, timings, err := matrixBalance(args)
+		if err != nil {
+			return printers.Grouping{}, err
+		}
+
+		grouping.Balance, grouping.Timings = balance, timings
+	case printers.GroupByApplication, printers.GroupByModule:
+	default:
+		return printers.Grouping{}, fmt.Errorf("invalid grouping: %s. Valid options are: %s, %s, %s", args.GroupBy, printers.GroupByShard, printers.GroupByApplication, printers.GroupByModule)
+	}
+
+	return grouping, nil
+}
+
+// newGitLabPipelinePrinter creates the gitlab-pipeline printer, reading the job template if given.
+func newGitLabPipelinePrinter(args *OutputArguments) (rippler.ReportPrinter, error) {
+	grouping, err := newGrouping(args)
 	if err != nil {
-		log.Fatalf("Failed to initialize rippler: %v\n", err)
+		return nil, err
+	}
+
+	jobTemplate := ""
+
+	if args.JobTemplate != "" {
+		text, rErr := os.ReadFile(args.JobTemplate)
+		if rErr != nil {
+			return nil, fmt.Errorf("failed to read job template: %w", rErr)
+		}
+
+		jobTemplate = string(text)
+	}
+
+	return printers.NewGitLabPipelinePrinter(grouping, jobTemplate)
+}
+
+// newTemplatePrinter creates the template printer, from an inline template or a template file.
+func newTemplatePrinter(args *OutputArguments) (rippler.ReportPrinter, error) {
+	switch {
+	case args.Template != "" && args.TemplateFile != "":
+		return nil, fmt.Errorf("--template and --template-file are mutually exclusive")
+	case args.Template != "":
+		return printers.NewTemplatePrinter(args.Template)
+	case args.TemplateFile != "":
+		text, err := os.ReadFile(args.TemplateFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template file: %w", err)
+		}
+
+		return printers.NewTemplatePrinter(string(text))
+	default:
+		return nil, fmt.Errorf("the template output requires --template or --template-file")
+	}
+}
+
+// graphCollapse parses the --collapse flag.
+func graphCollapse(mode string) (printers.GraphCollapse, error) {
+	switch collapse := printers.GraphCollapse(mode); collapse {
+	case printers.CollapseNone, printers.CollapseDirectories, printers.CollapseModules:
+		return collapse, nil
+	default:
+		return "", fmt.Errorf("invalid collapse mode: %s. Valid options are: %s, %s, %s", mode, printers.CollapseNone, printers.CollapseDirectories, printers.CollapseModules)
+	}
+}
+
+// ripplerOptions translates the given configuration into rippler options.
+func ripplerOptions(cfg *config.Config) []rippler.Option {
+	opts := make([]rippler.Option, 0)
+
+	if len(cfg.Ignore) > 0 {
+		opts = append(opts, rippler.WithIgnorePatterns(cfg.Ignore...))
+	}
+
+	if cfg.IgnoreGenerated {
+		opts = append(opts, rippler.WithIgnoreGeneratedFiles())
+	}
+
+	if cfg.MaxDepth > 0 {
+		opts = append(opts, rippler.WithMaxDepth(cfg.MaxDepth))
+	}
+
+	if len(cfg.Rules) > 0 {
+		rules := make([]rippler.FileRule, 0, len(cfg.Rules))
+
+		for i := range cfg.Rules {
+			rules = append(rules, rippler.FileRule{
+				Name:     cfg.Rules[i].Name,
+				Files:    cfg.Rules[i].Files,
+				Packages: cfg.Rules[i].Packages,
+			})
+		}
+
+		opts = append(opts, rippler.WithFileRules(rules...))
 	}
 
-	report, err := rip.Changes(context.TODO())
+	if len(cfg.GlobalTriggers) > 0 {
+		opts = append(opts, rippler.WithGlobalTriggers(cfg.GlobalTriggers...))
+	}
+
+	if len(cfg.Include) > 0 {
+		opts = append(opts, rippler.WithIncludedPackages(cfg.Include...))
+	}
+
+	if len(cfg.Exclude) > 0 {
+		opts = append(opts, rippler.WithExcludedPackages(cfg.Exclude...))
+	}
+
+	if len(cfg.Applications) > 0 {
+		apps := make([]rippler.ApplicationRoot, 0, len(cfg.Applications))
+
+		for i := range cfg.Applications {
+			apps = append(apps, rippler.ApplicationRoot{
+				Name:     cfg.Applications[i].Name,
+				Packages: cfg.Applications[i].Packages,
+			})
+		}
+
+		opts = append(opts, rippler.WithApplications(apps...))
+	}
+
+	if len(cfg.Artifacts) > 0 {
+		artifacts := make([]rippler.ArtifactRule, 0, len(cfg.Artifacts))
+
+		for i := range cfg.Artifacts {
+			artifacts = append(artifacts, rippler.ArtifactRule{
+				Name:     cfg.Artifacts[i].Name,
+				Kind:     cfg.Artifacts[i].Kind,
+				Packages: cfg.Artifacts[i].Packages,
+				Files:    cfg.Artifacts[i].Files,
+			})
+		}
+
+		opts = append(opts, rippler.WithArtifacts(artifacts...))
+	}
+
+	return opts
+}
+
+// loadConfig loads the configuration file at the given explicit path, or looks for one
+// at the module root and then at the repository root. An empty configuration is returned
+// when none is found.
+func loadConfig(modulePath string, explicitPath string) (*config.Config, error) {
+	path := explicitPath
+
+	if path == "" {
+		moduleDir, err := filepath.Abs(modulePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get absolute path for %s: %w", modulePath, err)
+		}
+
+		// Not being inside a Git repository is reported later on by the rippler itself.
+		repoRoot, _ := rippler.RepositoryRoot(context.TODO(), moduleDir)
+		path = config.Find(moduleDir, repoRoot)
+	}
+
+	if path == "" {
+		return &config.Config{}, nil
+	}
+
+	cfg, err := config.Load(path)
 	if err != nil {
-		log.Fatalf("Failed to get changes: %v\n", err)
+		return nil, err
 	}
 
-	if pErr := printer.Print(report); pErr != nil {
-		log.Fatalf("Failed to print report: %v\n", pErr)
+	if vErr := validateConfig(cfg); vErr != nil {
+		return nil, fmt.Errorf("invalid configuration file %s:\n%w", cfg.Path, vErr)
 	}
+
+	return cfg, nil
+}
+
+// validateConfig checks the configuration, including the values only known to this command.
+func validateConfig(cfg *config.Config) error {
+	err := cfg.Validate()
+
+	if cfg.Output != "" && !slices.Contains(outputFormats, cfg.Output) {
+		err = errors.Join(err, fmt.Errorf("output: invalid output format %q, valid options are: %s", cfg.Output, strings.Join(outputFormats, ", ")))
+	}
+
+	return err
+}
+
+// configCommand implements "go-ripple config <subcommand>".
+func configCommand(args []string) {
+	if len(args) == 0 || args[0] != "validate" {
+		fatal(exitUsage, "Usage: go-ripple config validate [--config FILE] [PATH]\n")
+	}
+
+	var cmdArgs ConfigValidateArguments
+
+	parser, err := arg.NewParser(arg.Config{Program: "go-ripple config validate"}, &cmdArgs)
+	if err != nil {
+		fatal(exitFailure, "Failed to initialize argument parser: %v\n", err)
+	}
+
+	parser.MustParse(args[1:])
+
+	cfg, err := loadConfig(cmdArgs.Path, cmdArgs.Config)
+	if err != nil {
+		fatal(exitConfig, "%v\n", err)
+	}
+
+	if cfg.Path == "" {
+		fatal(exitConfig, "No configuration file found, looked for %s\n", strings.Join(config.FileNames, ", "))
+	}
+
+	rip, err := rippler.NewRippler("", cmdArgs.Path, ripplerOptions(cfg)...)
+	if err != nil {
+		fatal(exitConfig, "Failed to initialize rippler: %v\n", err)
+	}
+
+	// Package patterns can only be checked against the packages of the module.
+	if pErr := rip.CheckPackagePatterns(context.TODO()); pErr != nil {
+		fatal(exitStatus(pErr), "invalid configuration file %s:\n%v\n", cfg.Path, pErr)
+	}
+
+	fmt.Printf("%s is valid\n", cfg.Path)
+}
+
+func firstNonEmpty(values ...string) string {
+	for i := range values {
+		if values[i] != "" {
+			return values[i]
+		}
+	}
+
+	return ""
 }
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+// output is a report printer along with the path of the file it writes to. An empty path,
+// or "-", stands for the standard output.
+type output struct {
+	printer rippler.ReportPrinter
+	path    string
+}
+
+// newOutputs creates the outputs requested with the --output flags, each given as
+// "format" or "format=path". The fallback format, printed to the standard output, is
+// used when no flag is given.
+func newOutputs(specs []string, fallback string, args *OutputArguments) ([]output, error) {
+	if len(specs) == 0 {
+		specs = []string{fallback}
+	}
+
+	outputs := make([]output, 0, len(specs))
+
+	for _, spec := range specs {
+		format, path, _ := strings.Cut(spec, "=")
+
+		printer, err := newPrinter(format, args, (path == "" || path == "-") && colorTerminal())
+		if err != nil {
+			return nil, err
+		}
+
+		outputs = append(outputs, output{printer: printer, path: path})
+	}
+
+	return outputs, nil
+}
+
+// colorTerminal reports whether the standard output is a terminal that may be colored, i.e.
+// a character device, unless disabled through the NO_COLOR environment variable.
+func colorTerminal() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	info, err := os.Stdout.Stat()
+
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// writeOutputs prints the report through every output, in order.
+func writeOutputs(report *rippler.Report, outputs []output) error {
+	for _, out := range outputs {
+		if err := out.write(report); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// write prints the report to the output file, or to the standard output.
+func (o output) write(report *rippler.Report) error {
+	if o.path == "" || o.path == "-" {
+		return o.print(os.Stdout, report)
+	}
+
+	f, err := os.Create(o.path)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	if pErr := o.print(f, report); pErr != nil {
+		_ = f.Close()
+
+		return pErr
+	}
+
+	if cErr := f.Close(); cErr != nil {
+		return fmt.Errorf("failed to write %s: %w", o.path, cErr)
+	}
+
+	return nil
+}
+
+func (o output) print(w io.Writer, report *rippler.Report) error {
+	if err := o.printer.Print(w, report); err != nil {
+		if o.path != "" && o.path != "-" {
+			return fmt.Errorf("failed to print report to %s: %w", o.path, err)
+		}
+
+		return fmt.Errorf("failed to print report: %w", err)
+	}
+
+	return nil
+}
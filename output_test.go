@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+func TestWriteOutputs(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "affected.json")
+	planPath := filepath.Join(dir, "plan.json")
+
+	outputs, err := newOutputs([]string{"json=" + jsonPath, "test-plan=" + planPath}, "plain", &OutputArguments{})
+	if err != nil {
+		t.Fatalf("newOutputs() error = %v", err)
+	}
+
+	report := &rippler.Report{
+		AffectedPackages: []model.AffectedPackage{{ImportPath: "example.com/project/users"}},
+	}
+
+	if wErr := writeOutputs(report, outputs); wErr != nil {
+		t.Fatalf("writeOutputs() error = %v", wErr)
+	}
+
+	for path, want := range map[string]string{
+		jsonPath: `"example.com/project/users"`,
+		planPath: `"shared": [`,
+	} {
+		data, rErr := os.ReadFile(path)
+		if rErr != nil {
+			t.Fatal(rErr)
+		}
+
+		if !strings.Contains(string(data), want) {
+			t.Errorf("%s =\n%s\nwant it to contain %s", filepath.Base(path), data, want)
+		}
+	}
+}
+
+func TestNewOutputs(t *testing.T) {
+	tests := []struct {
+		name      string
+		specs     []string
+		wantPaths []string
+		wantErr   bool
+	}{
+		{name: "fallback", wantPaths: []string{""}},
+		{name: "standard output", specs: []string{"json", "plain=-"}, wantPaths: []string{"", "-"}},
+		{name: "files", specs: []string{"json=out/a.json", "markdown=b.md"}, wantPaths: []string{"out/a.json", "b.md"}},
+		{name: "unknown format", specs: []string{"yaml=out.yaml"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputs, err := newOutputs(tt.specs, "plain", &OutputArguments{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newOutputs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			paths := make([]string, 0, len(outputs))
+			for _, out := range outputs {
+				paths = append(paths, out.path)
+			}
+
+			if !tt.wantErr && strings.Join(paths, ",") != strings.Join(tt.wantPaths, ",") {
+				t.Errorf("newOutputs() paths = %q, want %q", paths, tt.wantPaths)
+			}
+		})
+	}
+}
+
+func TestWriteOutputsMissingDirectory(t *testing.T) {
+	outputs, err := newOutputs([]string{"json=" + filepath.Join(t.TempDir(), "missing", "out.json")}, "plain", &OutputArguments{})
+	if err != nil {
+		t.Fatalf("newOutputs() error = %v", err)
+	}
+
+	wErr := writeOutputs(&rippler.Report{}, outputs)
+	if !errors.As(wErr, new(*fs.PathError)) {
+		t.Errorf("writeOutputs() error = %v, want a path error", wErr)
+	}
+}
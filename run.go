@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/alexflint/go-arg"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+// placeholder is the command argument replaced by the affected packages.
+const placeholder = "{}"
+
+// RunArguments holds the command line arguments for the "run" command.
+type RunArguments struct {
+	AnalysisArguments
+
+	Path     string   `arg:"-C,--path" placeholder:"PATH" help:"The path to the Go project directory (holding a go.mod file). Defaults to the current directory if not specified." default:"."`
+	Dirs     bool     `arg:"--dirs" help:"Substitute package directories, relative to the module root (e.g. './users'), instead of import paths."`
+	MaxArgs  int      `arg:"--max-args" placeholder:"N" help:"Maximum number of packages per command invocation. Zero means no limit other than the command line length." default:"0"`
+	MaxBytes int      `arg:"--max-bytes" placeholder:"N" help:"Maximum length, in bytes, of each command line. Keeps invocations well below the system ARG_MAX." default:"65536"`
+	Parallel int      `arg:"-p,--parallel" placeholder:"N" help:"Number of command invocations to run concurrently." default:"1"`
+	Command  []string `arg:"positional,required" placeholder:"COMMAND" help:"The command to run, e.g. 'go test -race {}'. The {} argument is replaced by the affected packages, which are appended when absent. Use -- to separate it from go-ripple flags."`
+}
+
+// Description returns the description of the "run" command, shown in its help text.
+func (RunArguments) Description() string {
+	return "Runs a command over the affected packages of a Go project, e.g.:\n\n" +
+		"  go-ripple run -b origin/main -- go test -race {}\n\n" +
+		"Nothing is run, successfully, when no package is affected. Long package lists are split\n" +
+		"into several invocations, and the exit status of the first failing one is passed through.\n"
+}
+
+// runCommand implements "go-ripple run [flags] -- command [args...]".
+func runCommand(args []string) {
+	var cmdArgs RunArguments
+
+	parser, err := arg.NewParser(arg.Config{Program: "go-ripple run"}, &cmdArgs)
+	if err != nil {
+		fatal(exitFailure, "Failed to initialize argument parser: %v\n", err)
+	}
+
+	parser.MustParse(args)
+
+	cfg, err := loadConfig(cmdArgs.Path, cmdArgs.Config)
+	if err != nil {
+		fatal(exitConfig, "Failed to load configuration: %v\n", err)
+	}
+
+	if cErr := applyCIBase(cfg, &cmdArgs.AnalysisArguments); cErr != nil {
+		fatal(exitStatus(cErr), "%v\n", cErr)
+	}
+
+	if aErr := applyArguments(cfg, &cmdArgs.AnalysisArguments); aErr != nil {
+		fatal(exitUsage, "%v\n", aErr)
+	}
+
+	report, err := analyze(context.TODO(), cfg, cmdArgs.Path)
+	if err != nil {
+		fatal(exitStatus(err), "%v\n", err)
+	}
+
+	targets := runTargets(report, cmdArgs.Dirs)
+	if len(targets) == 0 {
+		log.Println("No affected packages, nothing to run.")
+
+		return
+	}
+
+	chunks := chunkArguments(cmdArgs.Command, targets, cmdArgs.MaxArgs, cmdArgs.MaxBytes)
+
+	if code := runChunks(context.TODO(), report.ModuleDir, chunks, cmdArgs.Parallel); code != 0 {
+		os.Exit(code)
+	}
+}
+
+// runTargets returns the affected packages of the project to substitute in the command,
+// either as import paths or as directories relative to the module root. Affected third-party
+// packages are left out, as they cannot be addressed from within the project.
+func runTargets(report *rippler.Report, dirs bool) []string {
+	pkgDirs := make(map[string]string)
+	for i := range report.AllPackages {
+		pkgDirs[report.AllPackages[i].ImportPath] = report.AllPackages[i].Dir
+	}
+
+	out := make([]string, 0)
+
+	for i := range report.AffectedPackages {
+		dir, ok := pkgDirs[report.AffectedPackages[i].ImportPath]
+		if !ok || report.AffectedPackages[i].Indirect {
+			continue
+		}
+
+		if !dirs {
+			out = append(out, report.AffectedPackages[i].ImportPath)
+
+			continue
+		}
+
+		rel, err := filepath.Rel(report.ModuleDir, dir)
+		if err != nil {
+			rel = dir
+		}
+
+		out = append(out, "./"+filepath.ToSlash(rel))
+	}
+
+	return out
+}
+
+// chunkArguments builds the command lines to run, substituting the placeholder argument
+// with as many targets as fit within the given limits. Every chunk gets at least one target,
+// even if that exceeds maxBytes.
+func chunkArguments(command []string, targets []string, maxArgs int, maxBytes int) [][]string {
+	at := slices.Index(command, placeholder)
+	if at < 0 {
+		command = append(slices.Clone(command), placeholder)
+		at = len(command) - 1
+	}
+
+	baseBytes := 0
+	for i := range command {
+		if i != at {
+			baseBytes += len(command[i]) + 1
+		}
+	}
+
+	build := func(chunk []string) []string {
+		line := make([]string, 0, len(command)-1+len(chunk))
+		line = append(line, command[:at]...)
+		line = append(line, chunk...)
+
+		return append(line, command[at+1:]...)
+	}
+
+	out := make([][]string, 0)
+	chunk := make([]string, 0)
+	size := baseBytes
+
+	for _, target := range targets {
+		full := (maxArgs > 0 && len(chunk) >= maxArgs) || (maxBytes > 0 && size+len(target)+1 > maxBytes)
+
+		if full && len(chunk) > 0 {
+			out = append(out, build(chunk))
+			chunk = make([]string, 0)
+			size = baseBytes
+		}
+
+		chunk = append(chunk, target)
+		size += len(target) + 1
+	}
+
+	if len(chunk) > 0 {
+		out = append(out, build(chunk))
+	}
+
+	return out
+}
+
+// runChunks runs the given command lines, at most parallel at a time, in the given directory.
+// When running concurrently, the output of each invocation is buffered and written at once
+// so it does not interleave. It returns the exit status of the first failing invocation, in
+// chunk order, or zero if they all succeeded.
+func runChunks(ctx context.Context, dir string, chunks [][]string, parallel int) int {
+	parallel = max(1, parallel)
+	codes := make([]int, len(chunks))
+	sem := make(chan struct{}, parallel)
+	outputMu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+
+	for i := range chunks {
+		sem <- struct{}{}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			cmd := exec.CommandContext(ctx, chunks[i][0], chunks[i][1:]...)
+			cmd.Dir = dir
+			cmd.Stdin = os.Stdin
+
+			var out bytes.Buffer
+
+			if parallel == 1 {
+				cmd.Stdout = os.Stdout
+				cmd.Stderr = os.Stderr
+			} else {
+				cmd.Stdout = &out
+				cmd.Stderr = &out
+			}
+
+			codes[i] = exitCode(cmd.Run())
+
+			if parallel > 1 {
+				outputMu.Lock()
+				_, _ = io.Copy(os.Stdout, &out)
+				outputMu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for i := range codes {
+		if codes[i] != 0 {
+			return codes[i]
+		}
+	}
+
+	return 0
+}
+
+// exitCode extracts the exit status of a finished command. Commands that could not be
+// started at all are reported with status 127, as shells do.
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if code := exitErr.ExitCode(); code > 0 {
+			return code
+		}
+
+		return 1
+	}
+
+	log.Println(fmt.Errorf("failed to run command: %w", err))
+
+	return 127
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestChunkArguments(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  []string
+		targets  []string
+		maxArgs  int
+		maxBytes int
+		want     [][]string
+	}{
+		{
+			name:    "no targets",
+			command: []string{"go", "test", "{}"},
+			want:    [][]string{},
+		},
+		{
+			name:    "no limits",
+			command: []string{"go", "test", "{}"},
+			targets: []string{"./a", "./b", "./c"},
+			want:    [][]string{{"go", "test", "./a", "./b", "./c"}},
+		},
+		{
+			name:    "appended without placeholder",
+			command: []string{"go", "vet"},
+			targets: []string{"./a", "./b"},
+			want:    [][]string{{"go", "vet", "./a", "./b"}},
+		},
+		{
+			name:    "placeholder in the middle",
+			command: []string{"go", "test", "{}", "-run", "TestX"},
+			targets: []string{"./a", "./b"},
+			want:    [][]string{{"go", "test", "./a", "./b", "-run", "TestX"}},
+		},
+		{
+			name:    "argument limit",
+			command: []string{"go", "test", "{}"},
+			targets: []string{"./a", "./b", "./c"},
+			maxArgs: 2,
+			want: [][]string{
+				{"go", "test", "./a", "./b"},
+				{"go", "test", "./c"},
+			},
+		},
+		{
+			// "go test " takes 8 bytes and each target 4, so two targets fit in 16 bytes.
+			name:     "byte limit",
+			command:  []string{"go", "test", "{}"},
+			targets:  []string{"./a", "./b", "./c"},
+			maxBytes: 16,
+			want: [][]string{
+				{"go", "test", "./a", "./b"},
+				{"go", "test", "./c"},
+			},
+		},
+		{
+			name:     "oversized target still runs",
+			command:  []string{"go", "test", "{}"},
+			targets:  []string{"./a-very-long-package-path", "./b"},
+			maxBytes: 12,
+			want: [][]string{
+				{"go", "test", "./a-very-long-package-path"},
+				{"go", "test", "./b"},
+			},
+		},
+		{
+			name:     "byte limit reached first",
+			command:  []string{"go", "test", "{}"},
+			targets:  []string{"./a", "./b", "./c", "./d"},
+			maxArgs:  3,
+			maxBytes: 16,
+			want: [][]string{
+				{"go", "test", "./a", "./b"},
+				{"go", "test", "./c", "./d"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := append([]string(nil), tt.command...)
+
+			got := chunkArguments(command, tt.targets, tt.maxArgs, tt.maxBytes)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("chunkArguments() = %q, want %q", got, tt.want)
+			}
+
+			if !reflect.DeepEqual(command, tt.command) {
+				t.Errorf("chunkArguments() modified the command: %q, want %q", command, tt.command)
+			}
+		})
+	}
+}
+
+func TestRunChunks(t *testing.T) {
+	tests := []struct {
+		name     string
+		chunks   [][]string
+		parallel int
+		want     int
+	}{
+		{
+			name:     "all succeed",
+			chunks:   [][]string{{"true"}, {"true"}},
+			parallel: 1,
+			want:     0,
+		},
+		{
+			name:     "exit status passed through",
+			chunks:   [][]string{{"true"}, {"sh", "-c", "exit 3"}},
+			parallel: 1,
+			want:     3,
+		},
+		{
+			name:     "first failure in chunk order",
+			chunks:   [][]string{{"sh", "-c", "sleep 0.2; exit 3"}, {"sh", "-c", "exit 5"}},
+			parallel: 2,
+			want:     3,
+		},
+		{
+			name:     "failure after successes",
+			chunks:   [][]string{{"true"}, {"true"}, {"sh", "-c", "exit 4"}},
+			parallel: 3,
+			want:     4,
+		},
+		{
+			name:     "command not found",
+			chunks:   [][]string{{"go-ripple-no-such-command"}},
+			parallel: 1,
+			want:     127,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := runChunks(context.Background(), t.TempDir(), tt.chunks, tt.parallel); got != tt.want {
+				t.Errorf("runChunks() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunChunksSequentialOrder(t *testing.T) {
+	dir := t.TempDir()
+	chunks := [][]string{
+		{"sh", "-c", "echo a >> log"},
+		{"sh", "-c", "echo b >> log"},
+		{"sh", "-c", "echo c >> log"},
+	}
+
+	if code := runChunks(context.Background(), dir, chunks, 1); code != 0 {
+		t.Fatalf("runChunks() = %d, want 0", code)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "a\nb\nc\n" {
+		t.Errorf("chunks ran in the working directory as %q, want %q", got, "a\nb\nc\n")
+	}
+}
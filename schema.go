@@ -0,0 +1,16 @@
+package main
+
+import (
+	_ "embed"
+	"fmt"
+)
+
+// reportSchema is the JSON Schema of the document produced by the report-json output.
+//
+//go:embed schema/report.schema.json
+var reportSchema string
+
+// schemaCommand implements "go-ripple schema", printing the JSON Schema of the report-json output.
+func schemaCommand(_ []string) {
+	fmt.Print(reportSchema)
+}
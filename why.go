@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/alexflint/go-arg"
+	"github.com/tangelo-labs/go-ripple/internal/model"
+	"github.com/tangelo-labs/go-ripple/internal/rippler"
+)
+
+// WhyArguments holds the command line arguments for the "why" command.
+type WhyArguments struct {
+	AnalysisArguments
+
+	Path    string `arg:"-C,--path" placeholder:"PATH" help:"The path to the Go project directory (holding a go.mod file). Defaults to the current directory if not specified." default:"."`
+	All     bool   `arg:"--all" help:"Print every import path leading to the package, shortest first, instead of a single shortest one."`
+	Limit   int    `arg:"--limit" placeholder:"N" help:"Maximum number of import paths printed with --all. Zero means no limit." default:"100"`
+	Package string `arg:"positional,required" placeholder:"PACKAGE" help:"The affected package, as an import path or relative to the module root (e.g. './users')."`
+}
+
+// Description returns the description of the "why" command, shown in its help text.
+func (WhyArguments) Description() string {
+	return "Explains why a package is affected, printing the import path leading to it from a\n" +
+		"directly changed package, e.g.:\n\n" +
+		"  go-ripple why -b origin/main ./services/reporting\n\n" +
+		"Each hop is labeled with the kind of import it follows: a regular import, or an import\n" +
+		"from the internal (test) or external (xtest) test files of the importing package.\n"
+}
+
+// whyCommand implements "go-ripple why [flags] package".
+func whyCommand(args []string) {
+	var cmdArgs WhyArguments
+
+	parser, err := arg.NewParser(arg.Config{Program: "go-ripple why"}, &cmdArgs)
+	if err != nil {
+		fatal(exitFailure, "Failed to initialize argument parser: %v\n", err)
+	}
+
+	parser.MustParse(args)
+
+	cfg, err := loadConfig(cmdArgs.Path, cmdArgs.Config)
+	if err != nil {
+		fatal(exitConfig, "Failed to load configuration: %v\n", err)
+	}
+
+	if cErr := applyCIBase(cfg, &cmdArgs.AnalysisArguments); cErr != nil {
+		fatal(exitStatus(cErr), "%v\n", cErr)
+	}
+
+	if aErr := applyArguments(cfg, &cmdArgs.AnalysisArguments); aErr != nil {
+		fatal(exitUsage, "%v\n", aErr)
+	}
+
+	report, err := analyze(context.TODO(), cfg, cmdArgs.Path)
+	if err != nil {
+		fatal(exitStatus(err), "%v\n", err)
+	}
+
+	target := report.ResolvePackage(cmdArgs.Package)
+
+	// Packages reachable from a change may still be left out, e.g. beyond the maximum depth.
+	affected := slices.ContainsFunc(report.AffectedPackages, func(pkg model.AffectedPackage) bool {
+		return pkg.ImportPath == target
+	})
+
+	var chains [][]rippler.ImportEdge
+
+	switch {
+	case !affected:
+	case cmdArgs.All:
+		chains = rippler.AllChains(report, target, true, cmdArgs.Limit)
+	default:
+		if chain, ok := rippler.ShortestChain(report, target, true); ok {
+			chains = append(chains, chain)
+		}
+	}
+
+	if len(chains) == 0 {
+		// Not being affected is an answer rather than a failure.
+		fmt.Printf("%s is not affected by the changes\n", target)
+
+		return
+	}
+
+	for i, chain := range chains {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		printChain(report, target, chain)
+	}
+}
+
+// printChain prints a chain of imports, starting with the directly changed package and the
+// reasons it changed.
+func printChain(report *rippler.Report, target string, chain []rippler.ImportEdge) {
+	source := target
+	if len(chain) > 0 {
+		source = chain[0].Imported
+	}
+
+	for _, change := range report.DirectChanges(source) {
+		fmt.Printf("%s (directly changed)\n", change.PackageName)
+
+		for _, reason := range change.Reasons {
+			fmt.Printf("    %s\n", whyReason(report, reason))
+		}
+	}
+
+	for _, edge := range chain {
+		fmt.Printf("  -> %s (%s)\n", edge.Importer, edge.Kind)
+	}
+}
+
+// whyReason describes a change reason with its file relative to the repository root.
+func whyReason(report *rippler.Report, reason rippler.Reason) string {
+	if rel, err := filepath.Rel(report.RepositoryDir, reason.File); err == nil && reason.File != "" {
+		reason.File = filepath.ToSlash(rel)
+	}
+
+	return reason.String()
+}